@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"log"
+	"mucb_be/internal/config"
+	"mucb_be/internal/database"
+	"mucb_be/internal/infrastructure/security"
+	"time"
+)
+
+func main() {
+	log.Println("Starting field encryption migration...")
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("can not load config %v", err)
+	}
+
+	dbClient, err := database.ConnectMongoDB(cfg)
+	if err != nil {
+		log.Fatalf("can not connect mongodb %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = dbClient.Disconnect(ctx)
+	}()
+
+	fieldEncryptionService, err := security.NewFieldEncryptionService(cfg)
+	if err != nil {
+		log.Fatalf("can not create field encryption service %v", err)
+	}
+
+	err = database.MigrateFieldEncryption(dbClient.Database(cfg.DatabaseName), fieldEncryptionService)
+	if err != nil {
+		log.Fatalf("field encryption migration failed %v", err)
+	}
+
+	log.Println("Field encryption migration complete")
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"mucb_be/internal/config"
+	"mucb_be/internal/database"
+	"mucb_be/internal/infrastructure/storage"
+	"time"
+)
+
+func main() {
+	from := flag.String("from", storage.BackendLocal, "backend to copy images from: local, s3 or gridfs")
+	to := flag.String("to", "", "backend to copy images to: local, s3 or gridfs")
+	flag.Parse()
+
+	if *to == "" || *to == *from {
+		log.Fatalf("-to must name a backend other than -from")
+	}
+
+	log.Printf("Starting image storage migration from %s to %s...", *from, *to)
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("can not load config %v", err)
+	}
+
+	dbClient, err := database.ConnectMongoDB(cfg)
+	if err != nil {
+		log.Fatalf("can not connect mongodb %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = dbClient.Disconnect(ctx)
+	}()
+
+	db := dbClient.Database(cfg.DatabaseName)
+
+	source, err := storage.NewObjectStorage(*from, cfg, db)
+	if err != nil {
+		log.Fatalf("can not create %s storage %v", *from, err)
+	}
+
+	target, err := storage.NewObjectStorage(*to, cfg, db)
+	if err != nil {
+		log.Fatalf("can not create %s storage %v", *to, err)
+	}
+
+	err = database.MigrateImageStorage(db, source, target)
+	if err != nil {
+		log.Fatalf("image storage migration failed %v", err)
+	}
+
+	log.Println("Image storage migration complete, set STORAGE_BACKEND to the new backend")
+}
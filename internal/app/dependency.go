@@ -1,25 +1,51 @@
 package app
 
 import (
+	"fmt"
+	"log"
 	"mucb_be/internal/config"
 	"mucb_be/internal/database"
 	v1 "mucb_be/internal/delivery/http/v1"
+	"mucb_be/internal/domain/audit"
+	"mucb_be/internal/domain/idempotency"
+	"mucb_be/internal/infrastructure/auditing"
+	"mucb_be/internal/infrastructure/authorization"
+	"mucb_be/internal/infrastructure/clock"
+	"mucb_be/internal/infrastructure/imaging"
+	"mucb_be/internal/infrastructure/notification"
 	adminRepository "mucb_be/internal/infrastructure/repository/admin"
+	auditRepository "mucb_be/internal/infrastructure/repository/audit"
 	authRepository "mucb_be/internal/infrastructure/repository/auth"
 	cardRepository "mucb_be/internal/infrastructure/repository/card"
+	counsellingRepository "mucb_be/internal/infrastructure/repository/counselling"
 	healthScoreRepository "mucb_be/internal/infrastructure/repository/health_score"
+	idempotencyRepository "mucb_be/internal/infrastructure/repository/idempotency"
 	imageRepository "mucb_be/internal/infrastructure/repository/image"
+	permissionRepository "mucb_be/internal/infrastructure/repository/permission"
 	questionRepository "mucb_be/internal/infrastructure/repository/question"
+	questionnaireRepository "mucb_be/internal/infrastructure/repository/questionnaire"
 	recordRepository "mucb_be/internal/infrastructure/repository/record"
+	riskRepository "mucb_be/internal/infrastructure/repository/risk"
+	scheduleRepository "mucb_be/internal/infrastructure/repository/schedule"
 	userRepository "mucb_be/internal/infrastructure/repository/user"
+	"mucb_be/internal/infrastructure/sampling"
+	"mucb_be/internal/infrastructure/scheduling"
+	"mucb_be/internal/infrastructure/screening"
 	"mucb_be/internal/infrastructure/security"
+	"mucb_be/internal/infrastructure/storage"
 	adminUseCase "mucb_be/internal/usecase/admin"
+	auditUseCase "mucb_be/internal/usecase/audit"
 	authUseCase "mucb_be/internal/usecase/auth"
 	cardUseCase "mucb_be/internal/usecase/card"
+	counsellingUseCase "mucb_be/internal/usecase/counselling"
 	healthScoreUseCase "mucb_be/internal/usecase/health_score"
 	imageUseCase "mucb_be/internal/usecase/image"
+	permissionUseCase "mucb_be/internal/usecase/permission"
 	questionUseCase "mucb_be/internal/usecase/question"
+	questionnaireUseCase "mucb_be/internal/usecase/questionnaire"
 	recordUseCase "mucb_be/internal/usecase/record"
+	riskUseCase "mucb_be/internal/usecase/risk"
+	translationUseCase "mucb_be/internal/usecase/translation"
 	userUseCase "mucb_be/internal/usecase/user"
 
 	"go.mongodb.org/mongo-driver/mongo"
@@ -32,14 +58,25 @@ type Dependencies struct {
 	HashService       security.HashServiceInterface
 	EncryptionService security.EncryptionServiceInterface
 
-	AdminHandlerV1       *v1.AdminHandler
-	AuthHandlerV1        *v1.AuthHandler
-	UserHandlerV1        *v1.UserHandler
-	QuestionHandlerV1    *v1.QuestionHandler
-	RecordHandlerV1      *v1.RecordHandler
-	ImageHandlerV1       *v1.ImageHandler
-	CardHandlerV1        *v1.CardHandler
-	HealthScoreHandlerV1 *v1.HealthScoreHandler
+	AuthorizationService authorization.AuthorizationServiceInterface
+	AuditService         auditing.AuditServiceInterface
+
+	IdempotencyKeyRepo idempotency.IdempotencyKeyRepository
+
+	AdminHandlerV1         *v1.AdminHandler
+	AuthHandlerV1          *v1.AuthHandler
+	UserHandlerV1          *v1.UserHandler
+	QuestionHandlerV1      *v1.QuestionHandler
+	RecordHandlerV1        *v1.RecordHandler
+	ImageHandlerV1         *v1.ImageHandler
+	CardHandlerV1          *v1.CardHandler
+	HealthScoreHandlerV1   *v1.HealthScoreHandler
+	RiskHandlerV1          *v1.RiskHandler
+	CounsellingHandlerV1   *v1.CounsellingHandler
+	PermissionHandlerV1    *v1.PermissionHandler
+	AuditHandlerV1         *v1.AuditHandler
+	QuestionnaireHandlerV1 *v1.QuestionnaireHandler
+	TranslationHandlerV1   *v1.TranslationHandler
 }
 
 func NewDependencies(cfg *config.Config, dbClient *mongo.Client) *Dependencies {
@@ -47,6 +84,13 @@ func NewDependencies(cfg *config.Config, dbClient *mongo.Client) *Dependencies {
 	jwtService := security.NewJwtService(cfg)
 	encryptionService := security.NewEncryptionService(cfg)
 	hashService := security.NewHashService()
+	fieldEncryptionService, err := security.NewFieldEncryptionService(cfg)
+	if err != nil {
+		log.Fatalf("can not create field encryption service %v", err)
+	}
+	scheduleService := scheduling.NewScheduleService()
+	clockService := clock.NewSystemClock()
+	riskNotifier := notification.NewRiskNotifier(cfg)
 
 	db := dbClient.Database(cfg.DatabaseName)
 	adminCollection := db.Collection(database.AdminsCollection)
@@ -56,26 +100,106 @@ func NewDependencies(cfg *config.Config, dbClient *mongo.Client) *Dependencies {
 	otpAttemptCollection := db.Collection(database.OtpAttemptsCollection)
 	questionGroupCollection := db.Collection(database.QuestionGroupsCollection)
 	questionChoiceCollection := db.Collection(database.QuestionChoicesCollection)
+	questionRevisionCollection := db.Collection(database.QuestionRevisionsCollection)
+	questionnaireCollection := db.Collection(database.QuestionnairesCollection)
 	groupRecordCollection := db.Collection(database.GroupRecordsCollection)
 	imageCollection := db.Collection(database.ImageCollection)
 	cardCollection := db.Collection(database.CardCollection)
+	cardCategoryCollection := db.Collection(database.CardCategoriesCollection)
 	cardRecordCollection := db.Collection(database.CardRecordsCollection)
 	storyRecordCollection := db.Collection(database.StoryRecordsCollection)
 	healthScoreCollection := db.Collection(database.HealthScoresCollection)
+	submissionSlotCollection := db.Collection(database.SubmissionSlotsCollection)
+	idempotencyKeyCollection := db.Collection(database.IdempotencyKeysCollection)
+	activityScheduleCollection := db.Collection(database.ActivitySchedulesCollection)
+	riskKeywordCollection := db.Collection(database.RiskKeywordsCollection)
+	assignmentCollection := db.Collection(database.AssignmentsCollection)
+	counsellorNoteCollection := db.Collection(database.CounsellorNotesCollection)
+	accessLogCollection := db.Collection(database.AccessLogsCollection)
+	rolePermissionCollection := db.Collection(database.RolePermissionsCollection)
+	auditLogCollection := db.Collection(database.AuditLogsCollection)
+
+	objectStorage, err := storage.NewObjectStorage(cfg.StorageBackend, cfg, db)
+	if err != nil {
+		log.Fatalf("can not create object storage %v", err)
+	}
+	imageProcessor, err := imaging.NewImageProcessor(cfg)
+	if err != nil {
+		log.Fatalf("can not create image processor %v", err)
+	}
 
 	adminRepo := adminRepository.NewAdminRepositoryMongo(adminCollection)
 	authRepo := authRepository.NewAuthRepositoryMongo(tokenCollection)
-	userRepo := userRepository.NewUserRepositoryMongo(userCollection)
+	userRepo := userRepository.NewUserRepositoryMongo(userCollection, fieldEncryptionService)
 	otpRepo := authRepository.NewOtpRepositoryMongo(otpCollection)
 	otpAttemptRepo := authRepository.NewOtpAttemptRepositoryMongo(otpAttemptCollection)
 	questionGroupRepo := questionRepository.NewQuestionGroupRepositoryMongo(questionGroupCollection)
 	questionChoiceRepo := questionRepository.NewQuestionChoiceRepositoryMongo(questionChoiceCollection)
+	questionRevisionRepo := questionRepository.NewQuestionRevisionRepositoryMongo(questionRevisionCollection)
+	questionBankRepo := questionRepository.NewQuestionBankRepositoryMongo(questionGroupCollection, questionChoiceCollection, questionRevisionCollection, questionnaireCollection, groupRecordCollection)
+	questionnaireRepo := questionnaireRepository.NewQuestionnaireRepositoryMongo(questionnaireCollection)
 	groupRecordRepo := recordRepository.NewGroupRecordRepositoryMongo(groupRecordCollection)
-	imageRepo := imageRepository.NewImageRepositoryMongo(imageCollection)
-	cardRepo := cardRepository.NewCardRepositoryMongo(cardCollection)
+	imageRepo := imageRepository.NewImageRepositoryMongo(imageCollection, cardCollection, healthScoreCollection)
+	cardRepo := cardRepository.NewCardRepositoryMongo(cardCollection, cardRecordCollection)
+	cardCategoryRepo := cardRepository.NewCardCategoryRepositoryMongo(cardCategoryCollection)
 	cardRecordRepo := recordRepository.NewCardRecordRepositoryMongo(cardRecordCollection)
-	storyRecordRepo := recordRepository.NewStoryRecordRepositoryMongo(storyRecordCollection)
+	storyRecordRepo := recordRepository.NewStoryRecordRepositoryMongo(storyRecordCollection, fieldEncryptionService)
 	healthScoreRepo := healthScoreRepository.NewHealthScoreRepositoryMongo(healthScoreCollection)
+	submissionSlotRepo := recordRepository.NewSubmissionSlotRepositoryMongo(submissionSlotCollection)
+	idempotencyKeyRepo := idempotencyRepository.NewIdempotencyKeyRepositoryMongo(idempotencyKeyCollection)
+	activityScheduleRepo := scheduleRepository.NewActivityScheduleRepositoryMongo(activityScheduleCollection)
+	riskKeywordRepo := riskRepository.NewRiskKeywordRepositoryMongo(riskKeywordCollection)
+	assignmentRepo := counsellingRepository.NewAssignmentRepositoryMongo(assignmentCollection)
+	counsellorNoteRepo := counsellingRepository.NewCounsellorNoteRepositoryMongo(counsellorNoteCollection, fieldEncryptionService)
+	accessLogRepo := counsellingRepository.NewAccessLogRepositoryMongo(accessLogCollection)
+	rolePermissionRepo := permissionRepository.NewRolePermissionRepositoryMongo(rolePermissionCollection)
+	auditLogRepo := auditRepository.NewAuditLogRepositoryMongo(auditLogCollection)
+
+	availabilityService := scheduling.NewAvailabilityService(scheduleService, activityScheduleRepo, groupRecordRepo, cardRecordRepo)
+	choiceSampler := sampling.NewChoiceSampler(groupRecordRepo)
+	riskDetectionService := screening.NewRiskDetectionService(cfg, riskKeywordRepo)
+	authorizationService := authorization.NewAuthorizationService(rolePermissionRepo)
+	auditService := auditing.NewAuditService(auditLogRepo, map[string]auditing.SnapshotLoader{
+		audit.EntityAdmin: func(id string) (interface{}, error) {
+			return adminRepo.FindAdminById(id)
+		},
+		audit.EntityQuestionGroup: func(id string) (interface{}, error) {
+			return questionGroupRepo.FindQuestionGroupById(id)
+		},
+		audit.EntityQuestionChoice: func(id string) (interface{}, error) {
+			return questionChoiceRepo.FindQuestionChoiceById(id)
+		},
+		audit.EntityQuestionnaire: func(id string) (interface{}, error) {
+			return questionnaireRepo.FindQuestionnaireById(id)
+		},
+		audit.EntityImage: func(id string) (interface{}, error) {
+			return imageRepo.FindImageByID(id)
+		},
+		audit.EntityCard: func(id string) (interface{}, error) {
+			return cardRepo.FindCardById(id)
+		},
+		audit.EntityCardCategory: func(id string) (interface{}, error) {
+			return cardCategoryRepo.FindCardCategoryById(id)
+		},
+		audit.EntityHealthScore: func(id string) (interface{}, error) {
+			return healthScoreRepo.FindHealthScoreById(id)
+		},
+		audit.EntityRiskKeyword: func(id string) (interface{}, error) {
+			return riskKeywordRepo.FindRiskKeywordById(id)
+		},
+		audit.EntityRolePermission: func(role string) (interface{}, error) {
+			rolePermissions, err := rolePermissionRepo.FindAllRolePermissions()
+			if err != nil {
+				return nil, err
+			}
+			for _, rolePermission := range *rolePermissions {
+				if rolePermission.Role == role {
+					return rolePermission, nil
+				}
+			}
+			return nil, fmt.Errorf("data not found")
+		},
+	})
 
 	adminUseCase := adminUseCase.NewAdminUseCase(adminRepo, hashService)
 	authUseCase := authUseCase.NewAuthUseCase(
@@ -88,12 +212,27 @@ func NewDependencies(cfg *config.Config, dbClient *mongo.Client) *Dependencies {
 		hashService,
 		encryptionService,
 	)
-	userUseCase := userUseCase.NewUserUseCase(userRepo, groupRecordRepo, cardRecordRepo, storyRecordRepo, authRepo, jwtService)
-	questionUseCase := questionUseCase.NewAdminUseCase(questionGroupRepo, questionChoiceRepo, groupRecordRepo)
-	recordUseCase := recordUseCase.NewRecordUseCase(groupRecordRepo, cardRecordRepo, storyRecordRepo)
-	imageUseCase := imageUseCase.NewImageUseCase(imageRepo)
-	cardUseCase := cardUseCase.NewCardUseCase(cardRepo, imageRepo, cardRecordRepo)
-	healthScoreUseCase := healthScoreUseCase.NewHealthScoreUseCase(healthScoreRepo, imageRepo)
+	userUseCase := userUseCase.NewUserUseCase(userRepo, groupRecordRepo, cardRecordRepo, storyRecordRepo, submissionSlotRepo, counsellorNoteRepo, authRepo, jwtService, authorizationService)
+	questionUseCase := questionUseCase.NewAdminUseCase(questionGroupRepo, questionChoiceRepo, questionRevisionRepo, questionBankRepo, questionnaireRepo, scheduleService, availabilityService, choiceSampler, userRepo, clockService, authorizationService)
+	recordUseCase := recordUseCase.NewRecordUseCase(cfg, groupRecordRepo, cardRecordRepo, storyRecordRepo, submissionSlotRepo, questionGroupRepo, questionRevisionRepo, questionnaireRepo, cardRepo, cardCategoryRepo, userRepo, adminRepo, availabilityService, riskDetectionService, riskNotifier, clockService)
+	questionnaireUseCase := questionnaireUseCase.NewQuestionnaireUseCase(questionnaireRepo, questionGroupRepo, questionRevisionRepo, scheduleService, availabilityService, choiceSampler, userRepo, clockService)
+	imageUseCase := imageUseCase.NewImageUseCase(cfg, imageRepo, objectStorage, imageProcessor, clockService)
+	cardUseCase := cardUseCase.NewCardUseCase(cardRepo, cardRecordRepo, cardCategoryRepo, imageRepo, activityScheduleRepo, scheduleService, availabilityService, userRepo, clockService, authorizationService)
+	healthScoreUseCase := healthScoreUseCase.NewHealthScoreUseCase(healthScoreRepo, imageRepo, userRepo)
+	riskUseCase := riskUseCase.NewRiskUseCase(riskKeywordRepo, storyRecordRepo)
+	counsellingUseCase := counsellingUseCase.NewCounsellingUseCase(
+		assignmentRepo,
+		counsellorNoteRepo,
+		accessLogRepo,
+		adminRepo,
+		userRepo,
+		groupRecordRepo,
+		cardRecordRepo,
+		storyRecordRepo,
+	)
+	permissionUseCase := permissionUseCase.NewPermissionUseCase(rolePermissionRepo, authorizationService)
+	auditUseCase := auditUseCase.NewAuditUseCase(auditLogRepo)
+	translationUseCase := translationUseCase.NewTranslationUseCase(questionGroupRepo, questionChoiceRepo, cardRepo, cardCategoryRepo, healthScoreRepo)
 
 	adminHandlerV1 := v1.NewAdminHandler(adminUseCase)
 	authHandlerV1 := v1.NewAuthHandler(authUseCase)
@@ -103,6 +242,12 @@ func NewDependencies(cfg *config.Config, dbClient *mongo.Client) *Dependencies {
 	imageHandlerV1 := v1.NewImageHandler(imageUseCase)
 	cardHandlerV1 := v1.NewCardHandler(cardUseCase)
 	healthScoreHandlerV1 := v1.NewHealthScoreHandler(healthScoreUseCase)
+	riskHandlerV1 := v1.NewRiskHandler(riskUseCase)
+	counsellingHandlerV1 := v1.NewCounsellingHandler(counsellingUseCase)
+	permissionHandlerV1 := v1.NewPermissionHandler(permissionUseCase)
+	auditHandlerV1 := v1.NewAuditHandler(auditUseCase)
+	questionnaireHandlerV1 := v1.NewQuestionnaireHandler(questionnaireUseCase)
+	translationHandlerV1 := v1.NewTranslationHandler(translationUseCase)
 
 	return &Dependencies{
 		DBClient: dbClient,
@@ -111,13 +256,24 @@ func NewDependencies(cfg *config.Config, dbClient *mongo.Client) *Dependencies {
 		HashService:       hashService,
 		EncryptionService: encryptionService,
 
-		AdminHandlerV1:       adminHandlerV1,
-		AuthHandlerV1:        authHandlerV1,
-		UserHandlerV1:        userHandlerV1,
-		QuestionHandlerV1:    questionHandlerV1,
-		RecordHandlerV1:      recordHandlerV1,
-		ImageHandlerV1:       imageHandlerV1,
-		CardHandlerV1:        cardHandlerV1,
-		HealthScoreHandlerV1: healthScoreHandlerV1,
+		AuthorizationService: authorizationService,
+		AuditService:         auditService,
+
+		IdempotencyKeyRepo: idempotencyKeyRepo,
+
+		AdminHandlerV1:         adminHandlerV1,
+		AuthHandlerV1:          authHandlerV1,
+		UserHandlerV1:          userHandlerV1,
+		QuestionHandlerV1:      questionHandlerV1,
+		RecordHandlerV1:        recordHandlerV1,
+		ImageHandlerV1:         imageHandlerV1,
+		CardHandlerV1:          cardHandlerV1,
+		HealthScoreHandlerV1:   healthScoreHandlerV1,
+		RiskHandlerV1:          riskHandlerV1,
+		CounsellingHandlerV1:   counsellingHandlerV1,
+		PermissionHandlerV1:    permissionHandlerV1,
+		AuditHandlerV1:         auditHandlerV1,
+		QuestionnaireHandlerV1: questionnaireHandlerV1,
+		TranslationHandlerV1:   translationHandlerV1,
 	}
 }
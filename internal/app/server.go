@@ -22,6 +22,9 @@ func Start() (*Dependencies, *config.Config) {
 	deps := NewDependencies(cfg, dbClient)
 
 	database.SeedAdmin(dbClient.Database(cfg.DatabaseName), deps.HashService)
+	database.SeedRiskKeywords(dbClient.Database(cfg.DatabaseName))
+	database.SeedRolePermissions(dbClient.Database(cfg.DatabaseName))
+	database.SeedQuestionRevisions(dbClient.Database(cfg.DatabaseName))
 
 	return deps, cfg
 }
@@ -18,9 +18,30 @@ type Config struct {
 	AccessTokenKey           string
 	RefreshTokenKey          string
 	EncryptionKey            string
+	FieldEncryptionKeys      string
+	FieldEncryptionKeyId     string
+	BlindIndexKey            string
 	AccessTokenExpiredMinute string
 	ApiKey                   string
 	AllowOrigin              string
+	SyncMaxPastHour          string
+	SyncMaxFutureMinute      string
+	RiskFlagThreshold        string
+	RiskAlertWebhookUrl      string
+	StorageBackend           string
+	StorageLocalRoot         string
+	StorageGridFSBucket      string
+	S3Endpoint               string
+	S3Region                 string
+	S3Bucket                 string
+	S3AccessKey              string
+	S3SecretKey              string
+	ImageVariants            string
+	ImageMaxDimension        string
+	ImageMaxPixels           string
+	ImageCacheActive         string
+	ImageCacheInactive       string
+	ImageCacheArchived       string
 }
 
 func LoadConfig() (*Config, error) {
@@ -46,9 +67,30 @@ func LoadConfig() (*Config, error) {
 		AccessTokenKey:           os.Getenv("ACCESS_TOKEN_KEY"),
 		RefreshTokenKey:          os.Getenv("REFRESH_TOKEN_32_BYTES_KEY"),
 		EncryptionKey:            os.Getenv("ENCRYPTION_DATA_32_BYTES_KEY"),
+		FieldEncryptionKeys:      os.Getenv("FIELD_ENCRYPTION_KEYS"),
+		FieldEncryptionKeyId:     os.Getenv("FIELD_ENCRYPTION_ACTIVE_KEY_ID"),
+		BlindIndexKey:            os.Getenv("BLIND_INDEX_32_BYTES_KEY"),
 		AccessTokenExpiredMinute: os.Getenv("ACCESS_TOKEN_EXPIRED_MINUTE"),
 		ApiKey:                   os.Getenv("API_KEY"),
 		AllowOrigin:              os.Getenv("ALLOW_ORIGIN"),
+		SyncMaxPastHour:          os.Getenv("SYNC_MAX_PAST_HOUR"),
+		SyncMaxFutureMinute:      os.Getenv("SYNC_MAX_FUTURE_MINUTE"),
+		RiskFlagThreshold:        os.Getenv("RISK_FLAG_THRESHOLD"),
+		RiskAlertWebhookUrl:      os.Getenv("RISK_ALERT_WEBHOOK_URL"),
+		StorageBackend:           os.Getenv("STORAGE_BACKEND"),
+		StorageLocalRoot:         os.Getenv("STORAGE_LOCAL_ROOT"),
+		StorageGridFSBucket:      os.Getenv("STORAGE_GRIDFS_BUCKET"),
+		S3Endpoint:               os.Getenv("S3_ENDPOINT"),
+		S3Region:                 os.Getenv("S3_REGION"),
+		S3Bucket:                 os.Getenv("S3_BUCKET"),
+		S3AccessKey:              os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey:              os.Getenv("S3_SECRET_KEY"),
+		ImageVariants:            os.Getenv("IMAGE_VARIANTS"),
+		ImageMaxDimension:        os.Getenv("IMAGE_MAX_DIMENSION"),
+		ImageMaxPixels:           os.Getenv("IMAGE_MAX_PIXELS"),
+		ImageCacheActive:         os.Getenv("IMAGE_CACHE_CONTROL_ACTIVE"),
+		ImageCacheInactive:       os.Getenv("IMAGE_CACHE_CONTROL_INACTIVE"),
+		ImageCacheArchived:       os.Getenv("IMAGE_CACHE_CONTROL_ARCHIVED"),
 	}
 
 	return config, nil
@@ -1,17 +1,29 @@
 package database
 
 const (
-	UsersCollection           = "users"
-	TokensCollection          = "tokens"
-	AdminsCollection          = "admins"
-	OtpsCollection            = "otps"
-	OtpAttemptsCollection     = "otp_attempts"
-	QuestionGroupsCollection  = "question_groups"
-	QuestionChoicesCollection = "question_choices"
-	GroupRecordsCollection    = "group_records"
-	ImageCollection           = "images"
-	CardCollection            = "cards"
-	CardRecordsCollection     = "card_records"
-	StoryRecordsCollection    = "story_records"
-	HealthScoresCollection    = "health_scores"
+	UsersCollection             = "users"
+	TokensCollection            = "tokens"
+	AdminsCollection            = "admins"
+	OtpsCollection              = "otps"
+	OtpAttemptsCollection       = "otp_attempts"
+	QuestionGroupsCollection    = "question_groups"
+	QuestionChoicesCollection   = "question_choices"
+	GroupRecordsCollection      = "group_records"
+	ImageCollection             = "images"
+	CardCollection              = "cards"
+	CardRecordsCollection       = "card_records"
+	StoryRecordsCollection      = "story_records"
+	HealthScoresCollection      = "health_scores"
+	SubmissionSlotsCollection   = "submission_slots"
+	IdempotencyKeysCollection   = "idempotency_keys"
+	ActivitySchedulesCollection = "activity_schedules"
+	RiskKeywordsCollection      = "risk_keywords"
+	AssignmentsCollection       = "counsellor_assignments"
+	CounsellorNotesCollection   = "counsellor_notes"
+	AccessLogsCollection        = "counsellor_access_logs"
+	RolePermissionsCollection   = "role_permissions"
+	AuditLogsCollection         = "audit_logs"
+	QuestionRevisionsCollection = "question_revisions"
+	QuestionnairesCollection    = "questionnaires"
+	CardCategoriesCollection    = "card_categories"
 )
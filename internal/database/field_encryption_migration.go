@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"log"
+	"mucb_be/internal/infrastructure/security"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MigrateFieldEncryption encrypts plaintext phone numbers and story contents
+// in place and re-encrypts values written with a retired key. It is safe to
+// run repeatedly; documents already using the active key are skipped.
+func MigrateFieldEncryption(db *mongo.Database, fieldEncryptionService security.FieldEncryptionServiceInterface) error {
+	userCount, err := migrateEncryptedField(db.Collection(UsersCollection), "phone_number", fieldEncryptionService, func(plaintext string) bson.M {
+		return bson.M{"phone_number_index": fieldEncryptionService.BlindIndex(plaintext)}
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Encrypted phone numbers of %d users", userCount)
+
+	storyCount, err := migrateEncryptedField(db.Collection(StoryRecordsCollection), "content", fieldEncryptionService, nil)
+	if err != nil {
+		return err
+	}
+	log.Printf("Encrypted content of %d story records", storyCount)
+
+	return nil
+}
+
+func migrateEncryptedField(
+	collection *mongo.Collection,
+	field string,
+	fieldEncryptionService security.FieldEncryptionServiceInterface,
+	extraFields func(plaintext string) bson.M,
+) (int, error) {
+	ctx := context.Background()
+
+	opts := options.Find().SetProjection(bson.M{field: 1})
+	cursor, err := collection.Find(ctx, bson.M{field: bson.M{"$type": "string"}}, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	migrated := 0
+	for cursor.Next(ctx) {
+		var document bson.M
+		if err := cursor.Decode(&document); err != nil {
+			return migrated, err
+		}
+
+		id, _ := document["_id"].(primitive.ObjectID)
+		value, _ := document[field].(string)
+		if !fieldEncryptionService.NeedsEncryption(value) {
+			continue
+		}
+
+		plaintext, err := fieldEncryptionService.Decrypt(value)
+		if err != nil {
+			return migrated, err
+		}
+
+		encrypted, err := fieldEncryptionService.Encrypt(plaintext)
+		if err != nil {
+			return migrated, err
+		}
+
+		set := bson.M{field: encrypted}
+		if extraFields != nil {
+			for key, extra := range extraFields(plaintext) {
+				set[key] = extra
+			}
+		}
+
+		// ✅ Only overwrite the value we read, in case the app changed it meanwhile
+		_, err = collection.UpdateOne(ctx, bson.M{"_id": id, field: value}, bson.M{"$set": set})
+		if err != nil {
+			return migrated, err
+		}
+		migrated++
+	}
+
+	return migrated, cursor.Err()
+}
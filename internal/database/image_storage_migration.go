@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"log"
+	"mucb_be/internal/infrastructure/storage"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MigrateImageStorage copies the files of every image from one object storage
+// to another and points the image path at the object key. Source files are
+// kept, so it is safe to run again and to switch back until the old backend
+// is cleaned up by hand. Images whose file is missing are reported and
+// skipped.
+func MigrateImageStorage(db *mongo.Database, from, to storage.ObjectStorageInterface) error {
+	ctx := context.Background()
+	collection := db.Collection(ImageCollection)
+
+	opts := options.Find().SetProjection(bson.M{"name": 1, "content_type": 1, "variants": 1})
+	cursor, err := collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	copied, missing := 0, 0
+	for cursor.Next(ctx) {
+		var document struct {
+			ID          primitive.ObjectID `bson:"_id"`
+			Name        string             `bson:"name"`
+			ContentType string             `bson:"content_type"`
+			Variants    []struct {
+				Key         string `bson:"key"`
+				ContentType string `bson:"content_type"`
+			} `bson:"variants"`
+		}
+		if err := cursor.Decode(&document); err != nil {
+			return err
+		}
+
+		found, err := copyObject(from, to, document.Name, document.ContentType)
+		if err != nil {
+			return err
+		}
+		if !found {
+			log.Printf("Skipped image %s, file %s is missing", document.ID.Hex(), document.Name)
+			missing++
+			continue
+		}
+
+		// ✅ A missing variant is created again on its next request
+		for _, variant := range document.Variants {
+			if _, err := copyObject(from, to, variant.Key, variant.ContentType); err != nil {
+				return err
+			}
+		}
+
+		_, err = collection.UpdateOne(ctx, bson.M{"_id": document.ID}, bson.M{
+			"$set": bson.M{"path": document.Name},
+		})
+		if err != nil {
+			return err
+		}
+		copied++
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+
+	log.Printf("Copied %d images, %d files were missing", copied, missing)
+
+	return nil
+}
+
+func copyObject(from, to storage.ObjectStorageInterface, key, contentType string) (bool, error) {
+	body, _, err := from.Get(key)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer body.Close()
+
+	return true, to.Put(key, body, contentType)
+}
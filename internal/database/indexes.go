@@ -14,9 +14,15 @@ func CreateIndexes(db *mongo.Database) error {
 	indexModels := map[string][]mongo.IndexModel{
 		AdminsCollection: {
 			{Keys: bson.D{{Key: "email", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "receive_risk_alert", Value: 1}}},
 		},
 		UsersCollection: {
-			{Keys: bson.D{{Key: "phone_number", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{
+				Keys: bson.D{{Key: "phone_number_index", Value: 1}},
+				Options: options.Index().
+					SetUnique(true).
+					SetPartialFilterExpression(bson.M{"phone_number_index": bson.M{"$exists": true}}),
+			},
 		},
 		TokensCollection: {
 			{Keys: bson.D{{Key: "user", Value: 1}}},
@@ -28,6 +34,16 @@ func CreateIndexes(db *mongo.Database) error {
 		OtpAttemptsCollection: {
 			{Keys: bson.D{{Key: "phone_number", Value: 1}}},
 		},
+		ImageCollection: {
+			// ✅ Images that are not archived all share a missing archived_at,
+			// so each hash can be live only once
+			{
+				Keys: bson.D{{Key: "hash", Value: 1}, {Key: "archived_at", Value: 1}},
+				Options: options.Index().
+					SetUnique(true).
+					SetPartialFilterExpression(bson.M{"hash": bson.M{"$exists": true}}),
+			},
+		},
 		QuestionChoicesCollection: {
 			{Keys: bson.D{{Key: "question_group", Value: 1}}},
 		},
@@ -35,9 +51,15 @@ func CreateIndexes(db *mongo.Database) error {
 			{Keys: bson.D{{Key: "user", Value: 1}}},
 			{Keys: bson.D{{Key: "group_code", Value: 1}}},
 			{Keys: bson.D{{Key: "created_at", Value: 1}}},
+			{Keys: bson.D{{Key: "user", Value: 1}, {Key: "questionnaire", Value: 1}, {Key: "created_at", Value: 1}}},
+			{Keys: bson.D{{Key: "user", Value: 1}, {Key: "question_group", Value: 1}, {Key: "created_at", Value: 1}}},
+			{Keys: bson.D{{Key: "question_group", Value: 1}}},
+			{Keys: bson.D{{Key: "answers.choice", Value: 1}}},
 		},
 		CardRecordsCollection: {
 			{Keys: bson.D{{Key: "user", Value: 1}}},
+			{Keys: bson.D{{Key: "user", Value: 1}, {Key: "created_at", Value: 1}}},
+			{Keys: bson.D{{Key: "card", Value: 1}}},
 			{Keys: bson.D{{Key: "group_code", Value: 1}}},
 			{Keys: bson.D{{Key: "created_at", Value: 1}}},
 		},
@@ -45,10 +67,73 @@ func CreateIndexes(db *mongo.Database) error {
 			{Keys: bson.D{{Key: "user", Value: 1}}},
 			{Keys: bson.D{{Key: "group_code", Value: 1}}},
 			{Keys: bson.D{{Key: "created_at", Value: 1}}},
+			{Keys: bson.D{{Key: "flagged", Value: 1}, {Key: "created_at", Value: -1}}},
 		},
 		HealthScoresCollection: {
 			{Keys: bson.D{{Key: "maximum_percent", Value: 1}}, Options: options.Index().SetUnique(true)},
 		},
+		SubmissionSlotsCollection: {
+			{
+				Keys: bson.D{
+					{Key: "user", Value: 1},
+					{Key: "submission_type", Value: 1},
+					{Key: "target", Value: 1},
+					{Key: "slot_key", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+		IdempotencyKeysCollection: {
+			{Keys: bson.D{{Key: "user", Value: 1}, {Key: "key", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "created_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(24 * 60 * 60)},
+		},
+		ActivitySchedulesCollection: {
+			{Keys: bson.D{{Key: "activity", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		AssignmentsCollection: {
+			{
+				Keys: bson.D{
+					{Key: "counsellor", Value: 1},
+					{Key: "user", Value: 1},
+					{Key: "group_code", Value: 1},
+				},
+				Options: options.Index().SetUnique(true),
+			},
+		},
+		CounsellorNotesCollection: {
+			{Keys: bson.D{{Key: "counsellor", Value: 1}, {Key: "user", Value: 1}}},
+			{Keys: bson.D{{Key: "user", Value: 1}}},
+		},
+		AccessLogsCollection: {
+			{Keys: bson.D{{Key: "counsellor", Value: 1}, {Key: "created_at", Value: -1}}},
+			{Keys: bson.D{{Key: "created_at", Value: -1}}},
+		},
+		RolePermissionsCollection: {
+			{Keys: bson.D{{Key: "role", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		QuestionRevisionsCollection: {
+			{Keys: bson.D{{Key: "question_group", Value: 1}, {Key: "version", Value: -1}}, Options: options.Index().SetUnique(true)},
+		},
+		QuestionnairesCollection: {
+			{Keys: bson.D{{Key: "name", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "active", Value: 1}, {Key: "created_at", Value: 1}}},
+			{Keys: bson.D{{Key: "question_groups", Value: 1}}},
+		},
+		AuditLogsCollection: {
+			{Keys: bson.D{{Key: "created_at", Value: -1}}},
+			{Keys: bson.D{{Key: "actor", Value: 1}, {Key: "created_at", Value: -1}}},
+			{Keys: bson.D{{Key: "entity", Value: 1}, {Key: "target", Value: 1}, {Key: "created_at", Value: -1}}},
+		},
+		CardCollection: {
+			{Keys: bson.D{{Key: "category", Value: 1}, {Key: "position", Value: 1}}},
+			{Keys: bson.D{{Key: "tags", Value: 1}}},
+		},
+		CardCategoriesCollection: {
+			{Keys: bson.D{{Key: "code", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		RiskKeywordsCollection: {
+			{Keys: bson.D{{Key: "phrase", Value: 1}, {Key: "language", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
 	}
 
 	// Iterate over collections and create indexes
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"log"
+	"mucb_be/internal/domain/question"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// unversionedQuestionGroups matches groups saved before versioning. Those
+// never carry a draft flag, so drafts that have not been published yet keep
+// waiting for their first publish.
+var unversionedQuestionGroups = bson.M{
+	"revision": bson.M{"$exists": false},
+	"draft":    bson.M{"$exists": false},
+}
+
+// SeedQuestionRevisions gives question groups created before versioning their
+// first published revision, so every record submitted from now on can
+// reference one, and marks revisions saved before drafts existed as published
+// when they were created.
+func SeedQuestionRevisions(db *mongo.Database) {
+	groupCollection := db.Collection(QuestionGroupsCollection)
+	choiceCollection := db.Collection(QuestionChoicesCollection)
+	revisionCollection := db.Collection(QuestionRevisionsCollection)
+
+	_, err := revisionCollection.UpdateMany(context.Background(), bson.M{"published_at": bson.M{"$exists": false}}, mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"published_at": "$created_at"}}},
+	})
+	if err != nil {
+		log.Fatalf("Error backfilling revision publish dates: %v", err)
+	}
+
+	cursor, err := groupCollection.Find(context.Background(), unversionedQuestionGroups)
+	if err != nil {
+		log.Fatalf("Error finding unversioned question groups: %v", err)
+	}
+	defer cursor.Close(context.Background())
+
+	var questionGroups []question.QuestionGroup
+	if err := cursor.All(context.Background(), &questionGroups); err != nil {
+		log.Fatalf("Error decoding question groups: %v", err)
+	}
+
+	for _, questionGroup := range questionGroups {
+		choiceCursor, err := choiceCollection.Find(context.Background(), bson.M{"question_group": questionGroup.ID})
+		if err != nil {
+			log.Fatalf("Error finding choices of %s: %v", questionGroup.ID.Hex(), err)
+		}
+
+		var choices []question.QuestionChoice
+		if err := choiceCursor.All(context.Background(), &choices); err != nil {
+			log.Fatalf("Error decoding choices of %s: %v", questionGroup.ID.Hex(), err)
+		}
+
+		questionRevision := question.NewQuestionRevision(&questionGroup, choices, 1, questionGroup.CreatedAt)
+		_, err = revisionCollection.InsertOne(context.Background(), questionRevision)
+		if mongo.IsDuplicateKeyError(err) {
+			// ✅ A previous run stopped before linking the group; reuse its revision
+			err = revisionCollection.FindOne(context.Background(), bson.M{
+				"question_group": questionGroup.ID,
+				"version":        1,
+			}).Decode(questionRevision)
+		}
+		if err != nil {
+			log.Fatalf("Error inserting revision of %s: %v", questionGroup.ID.Hex(), err)
+		}
+
+		_, err = groupCollection.UpdateOne(context.Background(), bson.M{"_id": questionGroup.ID}, bson.M{
+			"$set": bson.M{"revision": questionRevision.ID, "version": 1},
+		})
+		if err != nil {
+			log.Fatalf("Error versioning question group %s: %v", questionGroup.ID.Hex(), err)
+		}
+	}
+
+	if len(questionGroups) > 0 {
+		log.Printf("Created first revision of %d question groups", len(questionGroups))
+	}
+}
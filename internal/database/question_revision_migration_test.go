@@ -0,0 +1,49 @@
+package database
+
+import (
+	"mucb_be/internal/domain/question"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// matchesExists evaluates a filter made of $exists conditions only.
+func matchesExists(document, filter bson.M) bool {
+	for field, condition := range filter {
+		_, present := document[field]
+		if present != condition.(bson.M)["$exists"].(bool) {
+			return false
+		}
+	}
+	return true
+}
+
+func toDocument(t *testing.T, value interface{}) bson.M {
+	raw, err := bson.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var document bson.M
+	if err := bson.Unmarshal(raw, &document); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	return document
+}
+
+func TestSeedQuestionRevisionsSkipsDrafts(t *testing.T) {
+	draft := question.NewQuestionGroup("mood", "How are you?", 3, nil, nil, nil, nil)
+
+	if matchesExists(toDocument(t, draft), unversionedQuestionGroups) {
+		t.Fatal("an unpublished draft would get a revision on restart")
+	}
+}
+
+func TestSeedQuestionRevisionsVersionsLegacyGroups(t *testing.T) {
+	legacy := toDocument(t, question.NewQuestionGroup("mood", "How are you?", 3, nil, nil, nil, nil))
+	delete(legacy, "draft")
+
+	if !matchesExists(legacy, unversionedQuestionGroups) {
+		t.Fatal("a group saved before versioning is not backfilled")
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"mucb_be/internal/domain/admin"
+	"mucb_be/internal/domain/permission"
+	"mucb_be/internal/domain/risk"
 	"mucb_be/internal/infrastructure/security"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -42,3 +44,98 @@ func SeedAdmin(db *mongo.Database, hashService security.HashServiceInterface) {
 
 	log.Println("SUPER_ADMIN seeded successfully!")
 }
+
+func SeedRiskKeywords(db *mongo.Database) {
+	collection := db.Collection(RiskKeywordsCollection)
+
+	count, err := collection.CountDocuments(context.Background(), bson.M{})
+	if err != nil {
+		log.Fatalf("Error checking risk keyword collection: %v", err)
+	}
+
+	if count > 0 {
+		log.Println("Risk keywords already exist. Skipping seeding.")
+		return
+	}
+
+	defaults := []struct {
+		phrase   string
+		language string
+		weight   int
+	}{
+		{"ฆ่าตัวตาย", risk.LanguageThai, 10},
+		{"อยากตาย", risk.LanguageThai, 10},
+		{"ไม่อยากมีชีวิตอยู่", risk.LanguageThai, 10},
+		{"จบชีวิต", risk.LanguageThai, 8},
+		{"กรีดข้อมือ", risk.LanguageThai, 8},
+		{"ทำร้ายตัวเอง", risk.LanguageThai, 6},
+		{"ไม่มีใครต้องการ", risk.LanguageThai, 3},
+		{"สิ้นหวัง", risk.LanguageThai, 3},
+		{"suicide", risk.LanguageEnglish, 10},
+		{"kill myself", risk.LanguageEnglish, 10},
+		{"want to die", risk.LanguageEnglish, 10},
+		{"end my life", risk.LanguageEnglish, 10},
+		{"better off dead", risk.LanguageEnglish, 8},
+		{"no reason to live", risk.LanguageEnglish, 8},
+		{"self harm", risk.LanguageEnglish, 6},
+		{"hurt myself", risk.LanguageEnglish, 6},
+		{"cut myself", risk.LanguageEnglish, 6},
+		{"hopeless", risk.LanguageEnglish, 3},
+	}
+
+	documents := make([]interface{}, 0, len(defaults))
+	for _, keyword := range defaults {
+		documents = append(documents, risk.NewRiskKeyword(keyword.phrase, keyword.language, keyword.weight))
+	}
+
+	_, err = collection.InsertMany(context.Background(), documents)
+	if err != nil {
+		log.Fatalf("Error inserting risk keywords: %v", err)
+	}
+
+	log.Println("Risk keywords seeded successfully!")
+}
+
+// SeedRolePermissions stores the default permissions for every role that has
+// no mapping yet. Mappings edited by a SUPER_ADMIN are never overwritten.
+func SeedRolePermissions(db *mongo.Database) {
+	collection := db.Collection(RolePermissionsCollection)
+
+	for _, role := range permission.Roles {
+		var existing permission.RolePermission
+		err := collection.FindOne(context.Background(), bson.M{"role": role}).Decode(&existing)
+		if err == mongo.ErrNoDocuments {
+			_, err = collection.InsertOne(context.Background(), permission.NewRolePermission(role, permission.DefaultRolePermissions[role]))
+			if err != nil {
+				log.Fatalf("Error seeding permissions for %s: %v", role, err)
+			}
+			continue
+		}
+		if err != nil {
+			log.Fatalf("Error seeding permissions for %s: %v", role, err)
+		}
+
+		// ✅ Grant only default permissions introduced since the last seed
+		known := make(map[string]bool, len(existing.Known))
+		for _, name := range existing.Known {
+			known[name] = true
+		}
+		added := make([]string, 0)
+		for _, name := range permission.DefaultRolePermissions[role] {
+			if !known[name] {
+				added = append(added, name)
+			}
+		}
+
+		update := bson.M{
+			"$set":      bson.M{"known_permissions": permission.All},
+			"$addToSet": bson.M{"permissions": bson.M{"$each": added}},
+		}
+		_, err = collection.UpdateOne(context.Background(), bson.M{"role": role}, update)
+		if err != nil {
+			log.Fatalf("Error seeding permissions for %s: %v", role, err)
+		}
+	}
+
+	log.Println("Role permissions seeded successfully!")
+}
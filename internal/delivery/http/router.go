@@ -3,8 +3,8 @@ package http
 import (
 	"mucb_be/internal/app"
 	"mucb_be/internal/config"
-	"mucb_be/internal/domain/admin"
-	"mucb_be/internal/domain/user"
+	"mucb_be/internal/domain/audit"
+	"mucb_be/internal/domain/permission"
 	"mucb_be/internal/middleware"
 
 	"github.com/gin-gonic/gin"
@@ -17,10 +17,14 @@ func SetupRouter(router *gin.Engine, cfg *config.Config, deps *app.Dependencies)
 	router.Use(middleware.ErrorHandlerMiddleware())
 	router.NoRoute(middleware.InvalidEndpointMiddleware())
 
-	allowedOnlySuperAdminRole := middleware.SpecificAuthMiddleware(deps.JwtService, []string{admin.RoleSuperAdmin})
-	allowedOnlyAdminRole := middleware.SpecificAuthMiddleware(deps.JwtService, []string{admin.RoleSuperAdmin, admin.RoleAdmin})
-	allowedOnlyUserRole := middleware.SpecificAuthMiddleware(deps.JwtService, []string{user.RoleUser})
-	allowedAllRole := middleware.SpecificAuthMiddleware(deps.JwtService, []string{admin.RoleSuperAdmin, admin.RoleAdmin, user.RoleUser})
+	authenticated := middleware.AuthMiddleware(deps.JwtService)
+	can := func(name string) gin.HandlerFunc {
+		return middleware.PermissionMiddleware(deps.JwtService, deps.AuthorizationService, name)
+	}
+	audited := func(action, entity, targetField string) gin.HandlerFunc {
+		return middleware.AuditMiddleware(deps.AuditService, action, entity, targetField)
+	}
+	idempotent := middleware.IdempotencyMiddleware(deps.IdempotencyKeyRepo)
 
 	api := router.Group("/api")
 	routesV1 := api.Group("/v1")
@@ -31,53 +35,119 @@ func SetupRouter(router *gin.Engine, cfg *config.Config, deps *app.Dependencies)
 	authRoutesV1.POST("/sign-in", deps.AuthHandlerV1.SignInUser)
 	authRoutesV1.POST("/verify-otp", deps.AuthHandlerV1.VerifyOtpUser)
 	authRoutesV1.POST("/renew", deps.AuthHandlerV1.RenewUser)
-	authRoutesV1.DELETE("/sign-out", allowedAllRole, deps.AuthHandlerV1.SignOut)
-	authRoutesV1.POST("/available-tokens", allowedAllRole, deps.AuthHandlerV1.GetAvailableTokens)
-	authRoutesV1.DELETE("/revoke", allowedAllRole, deps.AuthHandlerV1.RevokeToken)
+	authRoutesV1.DELETE("/sign-out", authenticated, deps.AuthHandlerV1.SignOut)
+	authRoutesV1.POST("/available-tokens", authenticated, deps.AuthHandlerV1.GetAvailableTokens)
+	authRoutesV1.DELETE("/revoke", authenticated, deps.AuthHandlerV1.RevokeToken)
 
 	adminRoutesV1 := routesV1.Group("/admin")
-	adminRoutesV1.POST("/create", allowedOnlySuperAdminRole, deps.AdminHandlerV1.CreateAdmin)
+	adminRoutesV1.POST("/create", can(permission.AdminWrite), audited(audit.ActionCreate, audit.EntityAdmin, ""), deps.AdminHandlerV1.CreateAdmin)
+	adminRoutesV1.PUT("/risk-alert", can(permission.AdminWrite), audited(audit.ActionUpdate, audit.EntityAdmin, "admin"), deps.AdminHandlerV1.UpdateRiskAlert)
 
 	userRoutesV1 := routesV1.Group("/user")
-	userRoutesV1.PUT("/update-info", allowedOnlyUserRole, deps.UserHandlerV1.UpdateUserInfo)
-	userRoutesV1.GET("/", allowedOnlyUserRole, deps.UserHandlerV1.GetUserInfo)
-	userRoutesV1.DELETE("/", allowedOnlyUserRole, deps.UserHandlerV1.RemoveUser)
+	userRoutesV1.PUT("/update-info", can(permission.ProfileManage), deps.UserHandlerV1.UpdateUserInfo)
+	userRoutesV1.GET("/", can(permission.ProfileManage), deps.UserHandlerV1.GetUserInfo)
+	userRoutesV1.DELETE("/", can(permission.ProfileManage), deps.UserHandlerV1.RemoveUser)
+	userRoutesV1.PUT("/counsellor-consent", can(permission.ProfileManage), deps.UserHandlerV1.UpdateCounsellorConsent)
 
 	questionRoutesV1 := routesV1.Group("/question")
-	questionRoutesV1.POST("/create-group", allowedOnlyAdminRole, deps.QuestionHandlerV1.CreateQuestionGroup)
-	questionRoutesV1.GET("/group", allowedOnlyAdminRole, deps.QuestionHandlerV1.GetAllQuestionGroups)
-	questionRoutesV1.POST("/create-choice", allowedOnlyAdminRole, deps.QuestionHandlerV1.CreateQuestionChoice)
-	questionRoutesV1.GET("/choice/:id", allowedOnlyAdminRole, deps.QuestionHandlerV1.FindAllQuestionChoiceByQuestionGroup)
-	questionRoutesV1.GET("/exam", allowedOnlyUserRole, deps.QuestionHandlerV1.GetQuestionWithRandomChoices)
-	questionRoutesV1.GET("/check-available-exam", allowedOnlyUserRole, deps.QuestionHandlerV1.CheckAvailableQuestion)
-	questionRoutesV1.PUT("/update-choice", allowedOnlyAdminRole, deps.QuestionHandlerV1.UpdateQuestion)
-	questionRoutesV1.DELETE("/choice", allowedOnlyAdminRole, deps.QuestionHandlerV1.RemoveChoice)
-	questionRoutesV1.DELETE("/group", allowedOnlyAdminRole, deps.QuestionHandlerV1.RemoveQuestionGroup)
-	questionRoutesV1.GET("/group/:id", allowedOnlyAdminRole, deps.QuestionHandlerV1.GetQuestionGroupById)
-	questionRoutesV1.PUT("/update-question-group", allowedOnlyAdminRole, deps.QuestionHandlerV1.UpdateQuestionGroup)
+	questionRoutesV1.POST("/create-group", can(permission.QuestionWrite), audited(audit.ActionCreate, audit.EntityQuestionGroup, ""), deps.QuestionHandlerV1.CreateQuestionGroup)
+	questionRoutesV1.GET("/group", can(permission.QuestionRead), deps.QuestionHandlerV1.GetAllQuestionGroups)
+	questionRoutesV1.POST("/create-choice", can(permission.QuestionWrite), audited(audit.ActionCreate, audit.EntityQuestionChoice, ""), deps.QuestionHandlerV1.CreateQuestionChoice)
+	questionRoutesV1.GET("/choice/:id", can(permission.QuestionRead), deps.QuestionHandlerV1.FindAllQuestionChoiceByQuestionGroup)
+	questionRoutesV1.GET("/exam", can(permission.ExamTake), deps.QuestionHandlerV1.GetQuestionWithRandomChoices)
+	questionRoutesV1.GET("/check-available-exam", can(permission.ExamTake), deps.QuestionHandlerV1.CheckAvailableQuestion)
+	questionRoutesV1.PUT("/update-choice", can(permission.QuestionWrite), audited(audit.ActionUpdate, audit.EntityQuestionChoice, "id"), deps.QuestionHandlerV1.UpdateQuestion)
+	questionRoutesV1.DELETE("/choice", can(permission.QuestionWrite), audited(audit.ActionRemove, audit.EntityQuestionChoice, "id"), deps.QuestionHandlerV1.RemoveChoice)
+	questionRoutesV1.DELETE("/group", can(permission.QuestionWrite), audited(audit.ActionRemove, audit.EntityQuestionGroup, "id"), deps.QuestionHandlerV1.RemoveQuestionGroup)
+	questionRoutesV1.PUT("/choice/archive", can(permission.QuestionWrite), audited(audit.ActionArchive, audit.EntityQuestionChoice, "id"), deps.QuestionHandlerV1.ArchiveChoice)
+	questionRoutesV1.PUT("/group/archive", can(permission.QuestionWrite), audited(audit.ActionArchive, audit.EntityQuestionGroup, "id"), deps.QuestionHandlerV1.ArchiveQuestionGroup)
+	questionRoutesV1.GET("/group/:id", can(permission.QuestionRead), deps.QuestionHandlerV1.GetQuestionGroupById)
+	questionRoutesV1.GET("/group/:id/revision", can(permission.QuestionRead), deps.QuestionHandlerV1.GetQuestionRevisions)
+	questionRoutesV1.GET("/group/:id/revision/diff", can(permission.QuestionRead), deps.QuestionHandlerV1.DiffQuestionRevisions)
+	questionRoutesV1.POST("/publish", can(permission.QuestionPublish), audited(audit.ActionPublish, audit.EntityQuestionGroup, "questionGroup"), deps.QuestionHandlerV1.PublishQuestionGroup)
+	questionRoutesV1.GET("/preview", can(permission.QuestionRead), deps.QuestionHandlerV1.PreviewQuestionGroups)
+	questionRoutesV1.PUT("/group/conditions", can(permission.QuestionWrite), audited(audit.ActionUpdate, audit.EntityQuestionGroup, "questionGroup"), deps.QuestionHandlerV1.UpdateQuestionGroupConditions)
+	questionRoutesV1.POST("/import", can(permission.QuestionWrite), audited(audit.ActionImport, audit.EntityQuestionGroup, ""), deps.QuestionHandlerV1.ImportQuestionBank)
+	questionRoutesV1.POST("/import/validate", can(permission.QuestionWrite), deps.QuestionHandlerV1.ValidateQuestionBank)
+	questionRoutesV1.GET("/export", can(permission.QuestionRead), deps.QuestionHandlerV1.ExportQuestionBank)
+	questionRoutesV1.PUT("/update-question-group", can(permission.QuestionWrite), audited(audit.ActionUpdate, audit.EntityQuestionGroup, "id"), deps.QuestionHandlerV1.UpdateQuestionGroup)
+
+	questionnaireRoutesV1 := routesV1.Group("/questionnaire")
+	questionnaireRoutesV1.POST("/create", can(permission.QuestionWrite), audited(audit.ActionCreate, audit.EntityQuestionnaire, ""), deps.QuestionnaireHandlerV1.CreateQuestionnaire)
+	questionnaireRoutesV1.GET("/", can(permission.QuestionRead), deps.QuestionnaireHandlerV1.GetAllQuestionnaires)
+	questionnaireRoutesV1.GET("/available", can(permission.ExamTake), deps.QuestionnaireHandlerV1.GetAvailableQuestionnaires)
+	questionnaireRoutesV1.GET("/:questionnaireId/exam", can(permission.ExamTake), deps.QuestionnaireHandlerV1.GetQuestionnaireExam)
+	questionnaireRoutesV1.POST("/:questionnaireId/exam/next", can(permission.ExamTake), deps.QuestionnaireHandlerV1.NextQuestionnaireStep)
+	questionnaireRoutesV1.GET("/:questionnaireId", can(permission.QuestionRead), deps.QuestionnaireHandlerV1.GetQuestionnaireById)
+	questionnaireRoutesV1.PUT("/update", can(permission.QuestionWrite), audited(audit.ActionUpdate, audit.EntityQuestionnaire, "questionnaire"), deps.QuestionnaireHandlerV1.UpdateQuestionnaire)
+	questionnaireRoutesV1.DELETE("/", can(permission.QuestionWrite), audited(audit.ActionRemove, audit.EntityQuestionnaire, "questionnaire"), deps.QuestionnaireHandlerV1.RemoveQuestionnaire)
 
 	recordRoutesV1 := routesV1.Group("/record")
-	recordRoutesV1.POST("/submit-group-answer", allowedOnlyUserRole, deps.RecordHandlerV1.SubmitGroupAnswer)
-	recordRoutesV1.POST("/submit-card-answer", allowedOnlyUserRole, deps.RecordHandlerV1.SubmitCardAnswer)
-	recordRoutesV1.POST("/submit-story-answer", allowedOnlyUserRole, deps.RecordHandlerV1.SubmitStoryAnswer)
+	recordRoutesV1.POST("/submit-group-answer", can(permission.RecordSubmit), idempotent, deps.RecordHandlerV1.SubmitGroupAnswer)
+	recordRoutesV1.POST("/submit-questionnaire-answer", can(permission.RecordSubmit), idempotent, deps.RecordHandlerV1.SubmitQuestionnaireAnswer)
+	recordRoutesV1.POST("/submit-card-answer", can(permission.RecordSubmit), idempotent, deps.RecordHandlerV1.SubmitCardAnswer)
+	recordRoutesV1.POST("/submit-story-answer", can(permission.RecordSubmit), idempotent, deps.RecordHandlerV1.SubmitStoryAnswer)
+	recordRoutesV1.POST("/sync", can(permission.RecordSubmit), idempotent, deps.RecordHandlerV1.SyncSubmissions)
+	recordRoutesV1.GET("/history", can(permission.RecordHistory), deps.RecordHandlerV1.GetHistory)
+	recordRoutesV1.GET("/card-summary", can(permission.RecordHistory), deps.RecordHandlerV1.GetCardSummary)
 
 	imageRoutesV1 := routesV1.Group("/image")
-	imageRoutesV1.POST("/upload", allowedOnlyAdminRole, deps.ImageHandlerV1.UploadImage)
+	imageRoutesV1.POST("/upload", can(permission.ImageUpload), audited(audit.ActionCreate, audit.EntityImage, ""), deps.ImageHandlerV1.UploadImage)
 	imageRoutesV1.GET("/:imageId", deps.ImageHandlerV1.GetImage)
 	imageRoutesV1.HEAD("/:imageId", deps.ImageHandlerV1.GetImage)
+	imageRoutesV1.PUT("/archive", can(permission.ImageUpload), audited(audit.ActionArchive, audit.EntityImage, "image"), deps.ImageHandlerV1.ArchiveImage)
+	imageRoutesV1.DELETE("/", can(permission.ImageUpload), audited(audit.ActionRemove, audit.EntityImage, "image"), deps.ImageHandlerV1.RemoveImage)
 
 	cardRoutesV1 := routesV1.Group("/card")
-	cardRoutesV1.POST("/create", allowedOnlyAdminRole, deps.CardHandlerV1.CreateCard)
-	cardRoutesV1.GET("/:cardId", allowedOnlyAdminRole, deps.CardHandlerV1.GetCard)
-	cardRoutesV1.GET("/", allowedAllRole, deps.CardHandlerV1.GetAllCards)
-	cardRoutesV1.POST("/activate", allowedOnlyAdminRole, deps.CardHandlerV1.ActivateCard)
-	cardRoutesV1.PUT("/update", allowedOnlyAdminRole, deps.CardHandlerV1.UpdateCard)
-	cardRoutesV1.GET("/check-available-card", allowedOnlyUserRole, deps.CardHandlerV1.CheckAvailableCard)
+	cardRoutesV1.POST("/create", can(permission.CardWrite), audited(audit.ActionCreate, audit.EntityCard, ""), deps.CardHandlerV1.CreateCard)
+	cardRoutesV1.GET("/schedule", can(permission.CardManage), deps.CardHandlerV1.GetCardSchedule)
+	cardRoutesV1.PUT("/schedule", can(permission.CardWrite), audited(audit.ActionUpdate, audit.EntityCardSchedule, ""), deps.CardHandlerV1.UpdateCardSchedule)
+	cardRoutesV1.POST("/category", can(permission.CardWrite), audited(audit.ActionCreate, audit.EntityCardCategory, ""), deps.CardHandlerV1.CreateCardCategory)
+	cardRoutesV1.GET("/statistics", can(permission.CardStatsRead), deps.CardHandlerV1.GetCardStatistics)
+	cardRoutesV1.GET("/category", can(permission.CardRead), deps.CardHandlerV1.GetAllCardCategories)
+	cardRoutesV1.PUT("/category", can(permission.CardWrite), audited(audit.ActionUpdate, audit.EntityCardCategory, "category"), deps.CardHandlerV1.UpdateCardCategory)
+	cardRoutesV1.GET("/:cardId", can(permission.CardManage), deps.CardHandlerV1.GetCard)
+	cardRoutesV1.GET("/", can(permission.CardRead), deps.CardHandlerV1.GetAllCards)
+	cardRoutesV1.POST("/activate", can(permission.CardActivate), audited(audit.ActionActivate, audit.EntityCard, "card"), deps.CardHandlerV1.ActivateCard)
+	cardRoutesV1.POST("/deactivate", can(permission.CardActivate), audited(audit.ActionDeactivate, audit.EntityCard, "card"), deps.CardHandlerV1.DeactivateCard)
+	cardRoutesV1.PUT("/order", can(permission.CardWrite), audited(audit.ActionUpdate, audit.EntityCard, ""), deps.CardHandlerV1.ReorderCards)
+	cardRoutesV1.PUT("/update", can(permission.CardWrite), audited(audit.ActionUpdate, audit.EntityCard, "card"), deps.CardHandlerV1.UpdateCard)
+	cardRoutesV1.PUT("/archive", can(permission.CardWrite), audited(audit.ActionArchive, audit.EntityCard, "card"), deps.CardHandlerV1.ArchiveCard)
+	cardRoutesV1.DELETE("/", can(permission.CardWrite), audited(audit.ActionRemove, audit.EntityCard, "card"), deps.CardHandlerV1.RemoveCard)
+	cardRoutesV1.GET("/check-available-card", can(permission.ExamTake), deps.CardHandlerV1.CheckAvailableCard)
 
 	healthScoreRoutesV1 := routesV1.Group("/health-score")
-	healthScoreRoutesV1.POST("/create", allowedOnlyAdminRole, deps.HealthScoreHandlerV1.CreateHealthScore)
-	healthScoreRoutesV1.GET("/", allowedOnlyAdminRole, deps.HealthScoreHandlerV1.GetAllHealthScore)
-	healthScoreRoutesV1.GET("/:healthScoreId", allowedOnlyAdminRole, deps.HealthScoreHandlerV1.GetHealthScore)
-	healthScoreRoutesV1.PUT("/update", allowedOnlyAdminRole, deps.HealthScoreHandlerV1.UpdateHealthScoreById)
-	healthScoreRoutesV1.POST("/score", allowedOnlyUserRole, deps.HealthScoreHandlerV1.GetContentByScore)
+	healthScoreRoutesV1.POST("/create", can(permission.HealthScoreWrite), audited(audit.ActionCreate, audit.EntityHealthScore, ""), deps.HealthScoreHandlerV1.CreateHealthScore)
+	healthScoreRoutesV1.GET("/", can(permission.HealthScoreRead), deps.HealthScoreHandlerV1.GetAllHealthScore)
+	healthScoreRoutesV1.GET("/:healthScoreId", can(permission.HealthScoreRead), deps.HealthScoreHandlerV1.GetHealthScore)
+	healthScoreRoutesV1.PUT("/update", can(permission.HealthScoreWrite), audited(audit.ActionUpdate, audit.EntityHealthScore, "healthScore"), deps.HealthScoreHandlerV1.UpdateHealthScoreById)
+	healthScoreRoutesV1.POST("/score", can(permission.ExamTake), deps.HealthScoreHandlerV1.GetContentByScore)
+
+	riskRoutesV1 := routesV1.Group("/risk")
+	riskRoutesV1.POST("/keyword", can(permission.RiskWrite), audited(audit.ActionCreate, audit.EntityRiskKeyword, ""), deps.RiskHandlerV1.CreateRiskKeyword)
+	riskRoutesV1.GET("/keyword", can(permission.RiskRead), deps.RiskHandlerV1.GetAllRiskKeywords)
+	riskRoutesV1.PUT("/keyword", can(permission.RiskWrite), audited(audit.ActionUpdate, audit.EntityRiskKeyword, "riskKeyword"), deps.RiskHandlerV1.UpdateRiskKeyword)
+	riskRoutesV1.DELETE("/keyword", can(permission.RiskWrite), audited(audit.ActionRemove, audit.EntityRiskKeyword, "riskKeyword"), deps.RiskHandlerV1.RemoveRiskKeyword)
+	riskRoutesV1.GET("/flagged-story", can(permission.RiskRead), deps.RiskHandlerV1.GetFlaggedStories)
+
+	counsellingRoutesV1 := routesV1.Group("/counselling")
+	counsellingRoutesV1.POST("/assignment", can(permission.CounsellingAssign), audited(audit.ActionCreate, audit.EntityAssignment, ""), deps.CounsellingHandlerV1.CreateAssignment)
+	counsellingRoutesV1.GET("/assignment/:counsellorId", can(permission.CounsellingAssign), deps.CounsellingHandlerV1.GetAssignments)
+	counsellingRoutesV1.DELETE("/assignment", can(permission.CounsellingAssign), audited(audit.ActionRemove, audit.EntityAssignment, "assignment"), deps.CounsellingHandlerV1.RemoveAssignment)
+	counsellingRoutesV1.GET("/access-log", can(permission.CounsellingAudit), deps.CounsellingHandlerV1.GetAccessLogs)
+	counsellingRoutesV1.GET("/participant", can(permission.CounsellingAccess), deps.CounsellingHandlerV1.GetParticipants)
+	counsellingRoutesV1.GET("/participant/:userId/record", can(permission.CounsellingAccess), deps.CounsellingHandlerV1.GetParticipantRecords)
+	counsellingRoutesV1.GET("/participant/:userId/note", can(permission.CounsellingAccess), deps.CounsellingHandlerV1.GetNotes)
+	counsellingRoutesV1.POST("/note", can(permission.CounsellingAccess), deps.CounsellingHandlerV1.CreateNote)
+
+	permissionRoutesV1 := routesV1.Group("/permission")
+	permissionRoutesV1.GET("/", can(permission.PermissionWrite), deps.PermissionHandlerV1.GetAllRolePermissions)
+	permissionRoutesV1.PUT("/", can(permission.PermissionWrite), audited(audit.ActionUpdate, audit.EntityRolePermission, "role"), deps.PermissionHandlerV1.UpdateRolePermission)
+	permissionRoutesV1.GET("/me", authenticated, deps.PermissionHandlerV1.GetMyPermissions)
+
+	translationRoutesV1 := routesV1.Group("/translation")
+	translationRoutesV1.GET("/missing", can(permission.TranslationRead), deps.TranslationHandlerV1.GetMissingTranslations)
+
+	auditRoutesV1 := routesV1.Group("/audit-log")
+	auditRoutesV1.GET("/", can(permission.AuditRead), deps.AuditHandlerV1.GetAuditLogs)
 }
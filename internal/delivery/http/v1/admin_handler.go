@@ -3,6 +3,7 @@ package v1
 import (
 	"mucb_be/internal/errors"
 	"mucb_be/internal/usecase/admin"
+	"mucb_be/internal/utils"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -25,7 +26,27 @@ func (h AdminHandler) CreateAdmin(c *gin.Context) {
 		return
 	}
 
-	err := h.adminUseCase.CreateAdmin(&request)
+	id, err := h.adminUseCase.CreateAdmin(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	utils.SetAuditTarget(c, id)
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h AdminHandler) UpdateRiskAlert(c *gin.Context) {
+	var request admin.UpdateRiskAlertRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.adminUseCase.UpdateRiskAlert(&request)
 	if err != nil {
 		c.Error(err)
 		return
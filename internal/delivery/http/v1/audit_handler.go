@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"mucb_be/internal/errors"
+	"mucb_be/internal/usecase/audit"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	auditUseCase audit.AuditInterface
+}
+
+func NewAuditHandler(auditUseCase audit.AuditInterface) *AuditHandler {
+	return &AuditHandler{
+		auditUseCase: auditUseCase,
+	}
+}
+
+func (h AuditHandler) GetAuditLogs(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001001", "Invalid page number", ""))
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 || limit > 50 {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001002", "Limit must be between 1 and 50", ""))
+		return
+	}
+
+	req := audit.GetAuditLogsRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if actor := c.Query("actor"); actor != "" {
+		req.Actor = &actor
+	}
+	if entity := c.Query("entity"); entity != "" {
+		req.Entity = &entity
+	}
+	if target := c.Query("target"); target != "" {
+		req.Target = &target
+	}
+	if from := c.Query("from"); from != "" {
+		req.From = &from
+	}
+	if to := c.Query("to"); to != "" {
+		req.To = &to
+	}
+
+	response, err := h.auditUseCase.FindAuditLogs(&req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -27,12 +27,14 @@ func (h CardHandler) CreateCard(c *gin.Context) {
 		return
 	}
 
-	err := h.cardUseCase.CreateCard(&request)
+	id, err := h.cardUseCase.CreateCard(&request)
 	if err != nil {
 		c.Error(err)
 		return
 	}
 
+	utils.SetAuditTarget(c, id)
+
 	c.JSON(http.StatusNoContent, nil)
 }
 
@@ -68,8 +70,12 @@ func (h *CardHandler) GetAllCards(c *gin.Context) {
 	}
 
 	req := card.GetCardsRequest{
-		Page:  page,
-		Limit: limit,
+		Page:           page,
+		Limit:          limit,
+		Category:       c.Query("category"),
+		Tag:            c.Query("tag"),
+		Archived:       c.Query("archived") == "true",
+		AcceptLanguage: c.GetHeader("Accept-Language"),
 	}
 
 	response, err := h.cardUseCase.FindAllCard(&req, claims)
@@ -99,6 +105,42 @@ func (h CardHandler) ActivateCard(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
+func (h CardHandler) DeactivateCard(c *gin.Context) {
+	var request card.DeactivateCard
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.cardUseCase.FindCardByIdAndDeactivate(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h CardHandler) ReorderCards(c *gin.Context) {
+	var request card.ReorderCardsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.cardUseCase.ReorderCards(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
 func (h CardHandler) UpdateCard(c *gin.Context) {
 	var request card.UpdateCardRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
@@ -117,6 +159,42 @@ func (h CardHandler) UpdateCard(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
+func (h CardHandler) ArchiveCard(c *gin.Context) {
+	var request card.ArchiveCardRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.cardUseCase.ArchiveCard(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h CardHandler) RemoveCard(c *gin.Context) {
+	var request card.RemoveCardRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.cardUseCase.RemoveCard(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
 func (h CardHandler) CheckAvailableCard(c *gin.Context) {
 	claims, err := utils.GetUserClaims(c)
 	if err != nil {
@@ -124,7 +202,93 @@ func (h CardHandler) CheckAvailableCard(c *gin.Context) {
 		return
 	}
 
-	err = h.cardUseCase.CheckAvailableCard(claims)
+	response, err := h.cardUseCase.CheckAvailableCard(claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h CardHandler) GetCardSchedule(c *gin.Context) {
+	response, err := h.cardUseCase.FindCardSchedule()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h CardHandler) UpdateCardSchedule(c *gin.Context) {
+	var request card.UpdateCardScheduleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.cardUseCase.UpdateCardSchedule(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h CardHandler) CreateCardCategory(c *gin.Context) {
+	var request card.CreateCardCategoryRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	id, err := h.cardUseCase.CreateCardCategory(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	utils.SetAuditTarget(c, id)
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h CardHandler) GetAllCardCategories(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	request := card.GetCardCategoriesRequest{
+		AcceptLanguage: c.GetHeader("Accept-Language"),
+	}
+
+	response, err := h.cardUseCase.FindAllCardCategories(&request, claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h CardHandler) UpdateCardCategory(c *gin.Context) {
+	var request card.UpdateCardCategoryRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.cardUseCase.UpdateCardCategory(&request)
 	if err != nil {
 		c.Error(err)
 		return
@@ -132,3 +296,27 @@ func (h CardHandler) CheckAvailableCard(c *gin.Context) {
 
 	c.JSON(http.StatusNoContent, nil)
 }
+
+func (h CardHandler) GetCardStatistics(c *gin.Context) {
+	request := card.GetCardStatisticsRequest{
+		Interval: c.DefaultQuery("interval", "DAY"),
+		Timezone: c.DefaultQuery("timezone", "UTC"),
+	}
+	if from := c.Query("from"); from != "" {
+		request.From = &from
+	}
+	if to := c.Query("to"); to != "" {
+		request.To = &to
+	}
+	if groupCode := c.Query("groupCode"); groupCode != "" {
+		request.GroupCode = &groupCode
+	}
+
+	response, err := h.cardUseCase.FindCardStatistics(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"mucb_be/internal/errors"
+	"mucb_be/internal/usecase/counselling"
+	"mucb_be/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CounsellingHandler struct {
+	counsellingUseCase counselling.CounsellingInterface
+}
+
+func NewCounsellingHandler(counsellingUseCase counselling.CounsellingInterface) *CounsellingHandler {
+	return &CounsellingHandler{
+		counsellingUseCase: counsellingUseCase,
+	}
+}
+
+func (h CounsellingHandler) CreateAssignment(c *gin.Context) {
+	var request counselling.CreateAssignmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	id, err := h.counsellingUseCase.CreateAssignment(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	utils.SetAuditTarget(c, id)
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h CounsellingHandler) GetAssignments(c *gin.Context) {
+	counsellorID := c.Param("counsellorId")
+
+	response, err := h.counsellingUseCase.FindAssignmentsByCounsellor(counsellorID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h CounsellingHandler) RemoveAssignment(c *gin.Context) {
+	var request counselling.RemoveAssignmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.counsellingUseCase.RemoveAssignment(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h CounsellingHandler) GetAccessLogs(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001001", "Invalid page number", ""))
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 || limit > 50 {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001002", "Limit must be between 1 and 50", ""))
+		return
+	}
+
+	req := counselling.GetAccessLogsRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if counsellor := c.Query("counsellor"); counsellor != "" {
+		req.Counsellor = &counsellor
+	}
+
+	response, err := h.counsellingUseCase.FindAccessLogs(&req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h CounsellingHandler) GetParticipants(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.counsellingUseCase.FindParticipants(claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h CounsellingHandler) GetParticipantRecords(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.counsellingUseCase.FindParticipantRecords(c.Param("userId"), claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h CounsellingHandler) CreateNote(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var request counselling.CreateNoteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err = h.counsellingUseCase.CreateNote(&request, claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h CounsellingHandler) GetNotes(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.counsellingUseCase.FindNotes(c.Param("userId"), claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"mucb_be/internal/errors"
 	"mucb_be/internal/usecase/health_score"
+	"mucb_be/internal/utils"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -27,12 +28,14 @@ func (h HealthScoreHandler) CreateHealthScore(c *gin.Context) {
 		return
 	}
 
-	err := h.healthScoreUseCase.CreateHealthScore(&request)
+	id, err := h.healthScoreUseCase.CreateHealthScore(&request)
 	if err != nil {
 		c.Error(err)
 		return
 	}
 
+	utils.SetAuditTarget(c, id)
+
 	c.JSON(http.StatusNoContent, nil)
 }
 
@@ -77,6 +80,12 @@ func (h HealthScoreHandler) UpdateHealthScoreById(c *gin.Context) {
 }
 
 func (h HealthScoreHandler) GetContentByScore(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
 	var request health_score.GetContentByScoreRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
 		c.Error(
@@ -84,8 +93,9 @@ func (h HealthScoreHandler) GetContentByScore(c *gin.Context) {
 		)
 		return
 	}
+	request.AcceptLanguage = c.GetHeader("Accept-Language")
 
-	response, err := h.healthScoreUseCase.FindContentByScore(&request)
+	response, err := h.healthScoreUseCase.FindContentByScore(&request, claims)
 	if err != nil {
 		c.Error(err)
 		return
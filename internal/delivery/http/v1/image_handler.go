@@ -2,14 +2,14 @@ package v1
 
 import (
 	"fmt"
-	"image"
-	_ "image/jpeg"
-	_ "image/png"
 	"mucb_be/internal/errors"
 	imageUseCase "mucb_be/internal/usecase/image"
 	"mucb_be/internal/utils"
 	"net/http"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -41,7 +41,7 @@ func (h ImageHandler) UploadImage(c *gin.Context) {
 	}
 
 	allowedExtensions := map[string]bool{".jpg": true, ".jpeg": true, ".png": true}
-	ext := filepath.Ext(file.Filename)
+	ext := strings.ToLower(filepath.Ext(file.Filename))
 	if !allowedExtensions[ext] {
 		c.Error(
 			errors.NewCustomError(http.StatusBadRequest, "VE001001", "File type not allowed", "File type not allowed"),
@@ -58,31 +58,13 @@ func (h ImageHandler) UploadImage(c *gin.Context) {
 	}
 	defer fileOpen.Close()
 
-	img, _, err := image.DecodeConfig(fileOpen)
-	if err != nil {
-		c.Error(
-			errors.NewCustomError(http.StatusBadRequest, "VE001003", "Invalid image format", err.Error()),
-		)
-		return
-	}
-
-	ext = filepath.Ext(file.Filename)
 	uniqueFilename := fmt.Sprintf("%s%s", uuid.New().String(), ext)
 
-	savePath := filepath.Join("uploads", uniqueFilename)
-
-	if err := c.SaveUploadedFile(file, savePath); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save file"})
-		return
-	}
-
 	request := imageUseCase.UploadImageRequest{
-		Path:         savePath,
+		File:         fileOpen,
 		Name:         uniqueFilename,
 		OriginalName: file.Filename,
-		Width:        img.Width,
-		Height:       img.Height,
-		ContentType:  file.Header.Get("Content-Type"),
+		Extension:    ext,
 	}
 
 	response, err := h.imageUseCase.CreateImage(request, claims)
@@ -91,27 +73,119 @@ func (h ImageHandler) UploadImage(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	utils.SetAuditTarget(c, response.ID.Hex())
 
+	c.JSON(http.StatusOK, response)
 }
 
+// GetImage serves an image or one of its variants. Conditional requests are
+// answered from the image document before the object is read, and
+// http.ServeContent takes care of range requests.
 func (h ImageHandler) GetImage(c *gin.Context) {
-	imageID := c.Param("imageId")
+	request := imageUseCase.FindImageVariantRequest{
+		Image: c.Param("imageId"),
+		Size:  c.Query("size"),
+	}
+	if value := c.Query("width"); value != "" {
+		width, err := strconv.Atoi(value)
+		if err != nil || width < 1 {
+			c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001002", "Width must be a positive number", ""))
+			return
+		}
+		request.Width = width
+	}
 
-	response, err := h.imageUseCase.FindImageById(imageID)
+	response, err := h.imageUseCase.FindImageVariant(&request)
 	if err != nil {
 		c.Error(err)
 		return
 	}
 
 	c.Header("Content-Type", response.ContentType)
+	c.Header("Cache-Control", response.CacheControl)
+	c.Header("Last-Modified", response.LastModified.UTC().Format(http.TimeFormat))
 	c.Header("X-Image-Width", fmt.Sprintf("%d", response.Width))
 	c.Header("X-Image-Height", fmt.Sprintf("%d", response.Height))
+	c.Header("X-Image-Variant", response.Variant)
+	if response.ETag != "" {
+		c.Header("ETag", response.ETag)
+	}
+
+	if notModified(c.Request, response.ETag, response.LastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
 
 	if c.Request.Method == http.MethodHead {
 		c.Status(http.StatusOK)
 		return
 	}
 
-	c.File(response.Path)
+	body, err := h.imageUseCase.OpenImageObject(response.Key)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	defer body.Close()
+
+	http.ServeContent(c.Writer, c.Request, response.Key, response.LastModified, body)
+}
+
+// notModified evaluates If-None-Match, or If-Modified-Since when there is no
+// If-None-Match, the way RFC 9110 orders them for GET and HEAD.
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if match := r.Header.Get("If-None-Match"); match != "" {
+		if etag == "" {
+			return false
+		}
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+func (h ImageHandler) ArchiveImage(c *gin.Context) {
+	var request imageUseCase.ArchiveImageRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.imageUseCase.ArchiveImage(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h ImageHandler) RemoveImage(c *gin.Context) {
+	var request imageUseCase.RemoveImageRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.imageUseCase.RemoveImage(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
 }
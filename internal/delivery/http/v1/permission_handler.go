@@ -0,0 +1,58 @@
+package v1
+
+import (
+	"mucb_be/internal/errors"
+	"mucb_be/internal/usecase/permission"
+	"mucb_be/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PermissionHandler struct {
+	permissionUseCase permission.PermissionInterface
+}
+
+func NewPermissionHandler(permissionUseCase permission.PermissionInterface) *PermissionHandler {
+	return &PermissionHandler{
+		permissionUseCase: permissionUseCase,
+	}
+}
+
+func (h PermissionHandler) GetAllRolePermissions(c *gin.Context) {
+	response, err := h.permissionUseCase.FindAllRolePermissions()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h PermissionHandler) UpdateRolePermission(c *gin.Context) {
+	var request permission.UpdateRolePermissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.permissionUseCase.UpdateRolePermission(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h PermissionHandler) GetMyPermissions(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.permissionUseCase.FindMyPermissions(claims))
+}
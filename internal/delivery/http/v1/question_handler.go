@@ -6,6 +6,7 @@ import (
 	"mucb_be/internal/utils"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -33,12 +34,14 @@ func (h QuestionHandler) CreateQuestionGroup(c *gin.Context) {
 		return
 	}
 
-	err = h.questionUseCase.CreateQuestionGroup(&request, claims)
+	id, err := h.questionUseCase.CreateQuestionGroup(&request, claims)
 	if err != nil {
 		c.Error(err)
 		return
 	}
 
+	utils.SetAuditTarget(c, id)
+
 	c.JSON(http.StatusNoContent, nil)
 }
 
@@ -63,8 +66,9 @@ func (h *QuestionHandler) GetAllQuestionGroups(c *gin.Context) {
 	}
 
 	req := question.GetQuestionGroupsRequest{
-		Page:  page,
-		Limit: limit,
+		Page:     page,
+		Limit:    limit,
+		Archived: c.Query("archived") == "true",
 	}
 
 	response, err := h.questionUseCase.FindAllQuestionGroup(&req, claims)
@@ -91,12 +95,14 @@ func (h QuestionHandler) CreateQuestionChoice(c *gin.Context) {
 		return
 	}
 
-	err = h.questionUseCase.CreateQuestionChoice(&request, claims)
+	id, err := h.questionUseCase.CreateQuestionChoice(&request, claims)
 	if err != nil {
 		c.Error(err)
 		return
 	}
 
+	utils.SetAuditTarget(c, id)
+
 	c.JSON(http.StatusNoContent, nil)
 }
 
@@ -109,7 +115,7 @@ func (h QuestionHandler) FindAllQuestionChoiceByQuestionGroup(c *gin.Context) {
 		return
 	}
 
-	response, err := h.questionUseCase.FindAllQuestionChoiceByQuestionGroup(idStr, claims)
+	response, err := h.questionUseCase.FindAllQuestionChoiceByQuestionGroup(idStr, c.Query("archived") == "true", claims)
 	if err != nil {
 		c.Error(err)
 		return
@@ -125,7 +131,9 @@ func (h QuestionHandler) GetQuestionWithRandomChoices(c *gin.Context) {
 		return
 	}
 
-	response, err := h.questionUseCase.GetQuestionWithRandomChoices(claims)
+	response, err := h.questionUseCase.GetQuestionWithRandomChoices(&question.GetQuestionWithRandomChoicesRequest{
+		AcceptLanguage: c.GetHeader("Accept-Language"),
+	}, claims)
 	if err != nil {
 		c.Error(err)
 		return
@@ -159,13 +167,13 @@ func (h QuestionHandler) CheckAvailableQuestion(c *gin.Context) {
 		return
 	}
 
-	err = h.questionUseCase.CheckAvailableQuestion(claims)
+	response, err := h.questionUseCase.CheckAvailableQuestion(claims)
 	if err != nil {
 		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusNoContent, nil)
+	c.JSON(http.StatusOK, response)
 }
 
 func (h QuestionHandler) RemoveChoice(c *gin.Context) {
@@ -204,6 +212,42 @@ func (h QuestionHandler) RemoveQuestionGroup(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
+func (h QuestionHandler) ArchiveQuestionGroup(c *gin.Context) {
+	var request question.ArchiveQuestionGroupRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.questionUseCase.ArchiveQuestionGroup(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h QuestionHandler) ArchiveChoice(c *gin.Context) {
+	var request question.ArchiveChoiceRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.questionUseCase.ArchiveChoice(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
 func (h QuestionHandler) GetQuestionGroupById(c *gin.Context) {
 	idStr := c.Param("id")
 
@@ -233,3 +277,146 @@ func (h QuestionHandler) UpdateQuestionGroup(c *gin.Context) {
 
 	c.JSON(http.StatusNoContent, nil)
 }
+
+func (h QuestionHandler) UpdateQuestionGroupConditions(c *gin.Context) {
+	var request question.UpdateQuestionGroupConditionsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.questionUseCase.UpdateQuestionGroupConditions(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h QuestionHandler) GetQuestionRevisions(c *gin.Context) {
+	response, err := h.questionUseCase.FindQuestionRevisions(c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h QuestionHandler) DiffQuestionRevisions(c *gin.Context) {
+	from, err := strconv.Atoi(c.Query("from"))
+	if err != nil || from < 1 {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001001", "Invalid from version", ""))
+		return
+	}
+
+	to, err := strconv.Atoi(c.Query("to"))
+	if err != nil || to < 1 {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001002", "Invalid to version", ""))
+		return
+	}
+
+	response, err := h.questionUseCase.DiffQuestionRevisions(&question.DiffQuestionRevisionsRequest{
+		QuestionGroup: c.Param("id"),
+		From:          from,
+		To:            to,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h QuestionHandler) PublishQuestionGroup(c *gin.Context) {
+	var request question.PublishQuestionGroupRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	response, err := h.questionUseCase.PublishQuestionGroup(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h QuestionHandler) PreviewQuestionGroups(c *gin.Context) {
+	req := question.PreviewQuestionGroupsRequest{
+		AcceptLanguage: c.GetHeader("Accept-Language"),
+	}
+	if questionGroup := c.Query("questionGroup"); questionGroup != "" {
+		req.QuestionGroup = &questionGroup
+	}
+
+	response, err := h.questionUseCase.PreviewQuestionGroups(&req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+const maxQuestionBankSize = 5 << 20
+
+func (h QuestionHandler) ImportQuestionBank(c *gin.Context) {
+	h.importQuestionBank(c, false)
+}
+
+func (h QuestionHandler) ValidateQuestionBank(c *gin.Context) {
+	h.importQuestionBank(c, true)
+}
+
+// ✅ JSON is read from the body unless it is sent as text/csv
+func (h QuestionHandler) importQuestionBank(c *gin.Context, dryRun bool) {
+	format := question.QuestionBankFormatJSON
+	if strings.HasPrefix(c.ContentType(), "text/csv") {
+		format = question.QuestionBankFormatCSV
+	}
+
+	response, err := h.questionUseCase.ImportQuestionBank(&question.ImportQuestionBankRequest{
+		Format:  format,
+		Content: http.MaxBytesReader(c.Writer, c.Request.Body, maxQuestionBankSize),
+		DryRun:  dryRun,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if len(response.Errors) > 0 {
+		c.JSON(http.StatusUnprocessableEntity, response)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h QuestionHandler) ExportQuestionBank(c *gin.Context) {
+	format := c.DefaultQuery("format", question.QuestionBankFormatJSON)
+	if format != question.QuestionBankFormatJSON && format != question.QuestionBankFormatCSV {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001001", "Format must be json or csv", ""))
+		return
+	}
+
+	response, err := h.questionUseCase.ExportQuestionBank(&question.ExportQuestionBankRequest{
+		Format: format,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+response.FileName+`"`)
+	c.Data(http.StatusOK, response.ContentType, response.Content)
+}
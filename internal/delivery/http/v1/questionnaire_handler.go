@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"mucb_be/internal/errors"
+	"mucb_be/internal/usecase/questionnaire"
+	"mucb_be/internal/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type QuestionnaireHandler struct {
+	questionnaireUseCase questionnaire.QuestionnaireInterface
+}
+
+func NewQuestionnaireHandler(questionnaireUseCase questionnaire.QuestionnaireInterface) *QuestionnaireHandler {
+	return &QuestionnaireHandler{
+		questionnaireUseCase: questionnaireUseCase,
+	}
+}
+
+func (h QuestionnaireHandler) CreateQuestionnaire(c *gin.Context) {
+	var request questionnaire.CreateQuestionnaireRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	id, err := h.questionnaireUseCase.CreateQuestionnaire(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	utils.SetAuditTarget(c, id)
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h QuestionnaireHandler) GetAllQuestionnaires(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001001", "Invalid page number", ""))
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 || limit > 50 {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001002", "Limit must be between 1 and 50", ""))
+		return
+	}
+
+	response, err := h.questionnaireUseCase.FindAllQuestionnaire(&questionnaire.GetQuestionnairesRequest{
+		Page:  page,
+		Limit: limit,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h QuestionnaireHandler) GetQuestionnaireById(c *gin.Context) {
+	response, err := h.questionnaireUseCase.GetQuestionnaireById(c.Param("questionnaireId"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h QuestionnaireHandler) UpdateQuestionnaire(c *gin.Context) {
+	var request questionnaire.UpdateQuestionnaireRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.questionnaireUseCase.UpdateQuestionnaire(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h QuestionnaireHandler) RemoveQuestionnaire(c *gin.Context) {
+	var request questionnaire.RemoveQuestionnaireRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.questionnaireUseCase.RemoveQuestionnaire(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h QuestionnaireHandler) GetAvailableQuestionnaires(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.questionnaireUseCase.FindAvailableQuestionnaires(claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h QuestionnaireHandler) GetQuestionnaireExam(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response, err := h.questionnaireUseCase.GetQuestionnaireExam(&questionnaire.GetQuestionnaireExamRequest{
+		Questionnaire:  c.Param("questionnaireId"),
+		AcceptLanguage: c.GetHeader("Accept-Language"),
+	}, claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h QuestionnaireHandler) NextQuestionnaireStep(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var request questionnaire.NextQuestionnaireStepRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+	request.Questionnaire = c.Param("questionnaireId")
+	request.AcceptLanguage = c.GetHeader("Accept-Language")
+
+	response, err := h.questionnaireUseCase.NextQuestionnaireStep(&request, claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
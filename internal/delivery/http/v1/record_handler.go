@@ -5,6 +5,7 @@ import (
 	"mucb_be/internal/usecase/record"
 	"mucb_be/internal/utils"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -41,6 +42,30 @@ func (h RecordHandler) SubmitGroupAnswer(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
+func (h RecordHandler) SubmitQuestionnaireAnswer(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var request record.CreateQuestionnaireRecordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	response, err := h.recordUseCase.CreateQuestionnaireRecord(&request, claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 func (h RecordHandler) SubmitCardAnswer(c *gin.Context) {
 	claims, err := utils.GetUserClaims(c)
 	if err != nil {
@@ -80,11 +105,84 @@ func (h RecordHandler) SubmitStoryAnswer(c *gin.Context) {
 		return
 	}
 
-	err = h.recordUseCase.CreateStoryRecord(&request, claims)
+	response, err := h.recordUseCase.CreateStoryRecord(&request, claims)
 	if err != nil {
 		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusNoContent, nil)
+	c.JSON(http.StatusOK, response)
+}
+
+func (h RecordHandler) SyncSubmissions(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var request record.SyncSubmissionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	response, err := h.recordUseCase.SyncSubmissions(&request, claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h RecordHandler) GetHistory(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days < 1 || days > 90 {
+		c.Error(errors.NewCustomError(http.StatusBadRequest, "VE001002", "Days must be between 1 and 90", ""))
+		return
+	}
+
+	req := record.GetHistoryRequest{
+		Days: days,
+	}
+
+	response, err := h.recordUseCase.GetHistory(&req, claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h RecordHandler) GetCardSummary(c *gin.Context) {
+	claims, err := utils.GetUserClaims(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	req := record.GetCardSummaryRequest{
+		AcceptLanguage: c.GetHeader("Accept-Language"),
+	}
+	if month := c.Query("month"); month != "" {
+		req.Month = &month
+	}
+
+	response, err := h.recordUseCase.GetCardSummary(&req, claims)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
 }
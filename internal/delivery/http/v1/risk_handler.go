@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"mucb_be/internal/errors"
+	"mucb_be/internal/usecase/risk"
+	"mucb_be/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RiskHandler struct {
+	riskUseCase risk.RiskInterface
+}
+
+func NewRiskHandler(riskUseCase risk.RiskInterface) *RiskHandler {
+	return &RiskHandler{
+		riskUseCase: riskUseCase,
+	}
+}
+
+func (h RiskHandler) CreateRiskKeyword(c *gin.Context) {
+	var request risk.CreateRiskKeywordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	id, err := h.riskUseCase.CreateRiskKeyword(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	utils.SetAuditTarget(c, id)
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h RiskHandler) GetAllRiskKeywords(c *gin.Context) {
+	response, err := h.riskUseCase.FindAllRiskKeywords()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h RiskHandler) UpdateRiskKeyword(c *gin.Context) {
+	var request risk.UpdateRiskKeywordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.riskUseCase.UpdateRiskKeyword(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h RiskHandler) RemoveRiskKeyword(c *gin.Context) {
+	var request risk.RemoveRiskKeywordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.Error(
+			errors.NewCustomError(http.StatusBadRequest, "VE001001", err.Error(), err.Error()),
+		)
+		return
+	}
+
+	err := h.riskUseCase.RemoveRiskKeyword(&request)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h RiskHandler) GetFlaggedStories(c *gin.Context) {
+	response, err := h.riskUseCase.FindFlaggedStories()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"mucb_be/internal/usecase/translation"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TranslationHandler struct {
+	translationUseCase translation.TranslationInterface
+}
+
+func NewTranslationHandler(translationUseCase translation.TranslationInterface) *TranslationHandler {
+	return &TranslationHandler{
+		translationUseCase: translationUseCase,
+	}
+}
+
+func (h TranslationHandler) GetMissingTranslations(c *gin.Context) {
+	req := translation.GetMissingTranslationsRequest{}
+	if locale := c.Query("locale"); locale != "" {
+		req.Locale = &locale
+	}
+
+	response, err := h.translationUseCase.FindMissingTranslations(&req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -7,13 +7,14 @@ import (
 )
 
 type Admin struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Name      string             `bson:"name" json:"name"`
-	Email     string             `bson:"email" json:"email"`
-	Password  string             `bson:"password" json:"-"`
-	Role      string             `bson:"role" json:"role"`
-	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
-	UpdatedAt time.Time          `bson:"updated_at" json:"updatedAt"`
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name             string             `bson:"name" json:"name"`
+	Email            string             `bson:"email" json:"email"`
+	Password         string             `bson:"password" json:"-"`
+	Role             string             `bson:"role" json:"role"`
+	ReceiveRiskAlert bool               `bson:"receive_risk_alert" json:"receiveRiskAlert"`
+	CreatedAt        time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt        time.Time          `bson:"updated_at" json:"updatedAt"`
 }
 
 func NewAdmin(name, email, hashedPassword, role string) *Admin {
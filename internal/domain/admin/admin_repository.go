@@ -4,6 +4,8 @@ type AdminRepository interface {
 	CreateAdmin(admin *Admin) error
 	FindAdminByEmail(email string) (*Admin, error)
 	FindAdminById(id string) (*Admin, error)
+	FindRiskAlertAdmins() (*[]Admin, error)
+	UpdateRiskAlertById(id string, receiveRiskAlert bool) error
 
 	// CreateUser(user *User) error
 
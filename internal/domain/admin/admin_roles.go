@@ -3,4 +3,5 @@ package admin
 const (
 	RoleSuperAdmin = "SUPER_ADMIN"
 	RoleAdmin      = "ADMIN"
+	RoleCounsellor = "COUNSELLOR"
 )
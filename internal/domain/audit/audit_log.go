@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	ActionCreate     = "CREATE"
+	ActionUpdate     = "UPDATE"
+	ActionRemove     = "REMOVE"
+	ActionActivate   = "ACTIVATE"
+	ActionDeactivate = "DEACTIVATE"
+	ActionPublish    = "PUBLISH"
+	ActionImport     = "IMPORT"
+	ActionArchive    = "ARCHIVE"
+)
+
+const (
+	EntityAdmin          = "ADMIN"
+	EntityQuestionGroup  = "QUESTION_GROUP"
+	EntityQuestionChoice = "QUESTION_CHOICE"
+	EntityQuestionnaire  = "QUESTIONNAIRE"
+	EntityImage          = "IMAGE"
+	EntityCard           = "CARD"
+	EntityCardSchedule   = "CARD_SCHEDULE"
+	EntityCardCategory   = "CARD_CATEGORY"
+	EntityHealthScore    = "HEALTH_SCORE"
+	EntityRiskKeyword    = "RISK_KEYWORD"
+	EntityAssignment     = "COUNSELLOR_ASSIGNMENT"
+	EntityRolePermission = "ROLE_PERMISSION"
+)
+
+type FieldChange struct {
+	Field  string      `bson:"field" json:"field"`
+	Before interface{} `bson:"before" json:"before"`
+	After  interface{} `bson:"after" json:"after"`
+}
+
+// AuditLog is append-only: entries are never updated or removed by the API.
+type AuditLog struct {
+	ID        primitive.ObjectID     `bson:"_id,omitempty" json:"id"`
+	Actor     primitive.ObjectID     `bson:"actor" json:"actor"`
+	Role      string                 `bson:"role" json:"role"`
+	Action    string                 `bson:"action" json:"action"`
+	Entity    string                 `bson:"entity" json:"entity"`
+	Target    string                 `bson:"target,omitempty" json:"target,omitempty"`
+	Before    map[string]interface{} `bson:"before,omitempty" json:"before,omitempty"`
+	After     map[string]interface{} `bson:"after,omitempty" json:"after,omitempty"`
+	Changes   []FieldChange          `bson:"changes" json:"changes"`
+	IP        string                 `bson:"ip" json:"ip"`
+	UserAgent string                 `bson:"user_agent" json:"userAgent"`
+	CreatedAt time.Time              `bson:"created_at" json:"createdAt"`
+}
+
+func NewAuditLog(
+	actor primitive.ObjectID,
+	role, action, entity, target string,
+	before, after map[string]interface{},
+	ip, userAgent string,
+) *AuditLog {
+	return &AuditLog{
+		ID:        primitive.NewObjectID(),
+		Actor:     actor,
+		Role:      role,
+		Action:    action,
+		Entity:    entity,
+		Target:    target,
+		Before:    before,
+		After:     after,
+		Changes:   Diff(before, after),
+		IP:        ip,
+		UserAgent: userAgent,
+		CreatedAt: time.Now(),
+	}
+}
+
+// Diff lists the top-level fields whose values differ between two snapshots,
+// sorted by field name.
+func Diff(before, after map[string]interface{}) []FieldChange {
+	fields := make(map[string]bool, len(before)+len(after))
+	for field := range before {
+		fields[field] = true
+	}
+	for field := range after {
+		fields[field] = true
+	}
+
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		if field == "updatedAt" {
+			continue
+		}
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	changes := make([]FieldChange, 0)
+	for _, field := range names {
+		if reflect.DeepEqual(before[field], after[field]) {
+			continue
+		}
+		changes = append(changes, FieldChange{
+			Field:  field,
+			Before: before[field],
+			After:  after[field],
+		})
+	}
+
+	return changes
+}
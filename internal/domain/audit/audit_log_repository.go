@@ -0,0 +1,20 @@
+package audit
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditLogFilter struct {
+	Actor  *primitive.ObjectID
+	Entity *string
+	Target *string
+	From   *time.Time
+	To     *time.Time
+}
+
+type AuditLogRepository interface {
+	CreateAuditLog(auditLog *AuditLog) error
+	FindAuditLogs(filter AuditLogFilter, page, limit int) (*[]AuditLog, int, error)
+}
@@ -1,29 +1,70 @@
 package card
 
 import (
+	"mucb_be/internal/domain/locale"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+type CardText struct {
+	Name        string `bson:"name" json:"name" binding:"required"`
+	Description string `bson:"description" json:"description" binding:"required,max=2048"`
+}
+
 type Card struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Name        string             `bson:"name" json:"name"`
-	Image       primitive.ObjectID `bson:"image" json:"image"`
-	Description string             `bson:"description" json:"description"`
-	IsActive    bool               `bson:"is_active" json:"isActive"`
-	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
-	UpdatedAt   time.Time          `bson:"updated_at" json:"updatedAt"`
+	ID           primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	Name         string              `bson:"name" json:"name"`
+	Image        primitive.ObjectID  `bson:"image" json:"image"`
+	Description  string              `bson:"description" json:"description"`
+	IsActive     bool                `bson:"is_active" json:"isActive"`
+	Position     int                 `bson:"position" json:"position"`
+	Category     string              `bson:"category,omitempty" json:"category,omitempty"`
+	Tags         []string            `bson:"tags,omitempty" json:"tags,omitempty"`
+	Translations map[string]CardText `bson:"translations,omitempty" json:"translations,omitempty"`
+	ArchivedAt   *time.Time          `bson:"archived_at,omitempty" json:"archivedAt,omitempty"`
+	CreatedAt    time.Time           `bson:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time           `bson:"updated_at" json:"updatedAt"`
 }
 
-func NewCard(name, description string, image primitive.ObjectID) *Card {
+// CardFilter narrows the card listing. Archived lists archived cards instead
+// of the others.
+type CardFilter struct {
+	Category string
+	Tag      string
+	Archived bool
+}
+
+func NewCard(name, description string, image primitive.ObjectID, category string, tags []string, translations map[string]CardText) *Card {
 	return &Card{
-		ID:          primitive.NewObjectID(),
-		Name:        name,
-		Image:       image,
-		Description: description,
-		IsActive:    false,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		ID:           primitive.NewObjectID(),
+		Name:         name,
+		Image:        image,
+		Description:  description,
+		IsActive:     false,
+		Category:     category,
+		Tags:         tags,
+		Translations: translations,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
 	}
 }
+
+func (c *Card) Localize(name string) {
+	if text, ok := c.Translations[name]; ok {
+		if text.Name != "" {
+			c.Name = text.Name
+		}
+		if text.Description != "" {
+			c.Description = text.Description
+		}
+	}
+	c.Translations = nil
+}
+
+func (c *Card) MissingLocales() []string {
+	return locale.Missing(func(name string) bool {
+		text, ok := c.Translations[name]
+		return ok && text.Name != "" && text.Description != ""
+	})
+}
@@ -0,0 +1,84 @@
+package card
+
+import (
+	"fmt"
+	"mucb_be/internal/domain/locale"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// DefaultSelectionLimit caps the cards without a category a participant
+// picks in one submission.
+const DefaultSelectionLimit = 5
+
+type CardCategoryText struct {
+	Name string `bson:"name" json:"name" binding:"required,max=64"`
+}
+
+// CardCategory groups cards, for example emotions or coping strategies.
+// Limit is how many cards of the category one submission may hold.
+type CardCategory struct {
+	ID           primitive.ObjectID          `bson:"_id,omitempty" json:"id"`
+	Code         string                      `bson:"code" json:"code"`
+	Name         string                      `bson:"name" json:"name"`
+	Limit        int                         `bson:"limit" json:"limit"`
+	Translations map[string]CardCategoryText `bson:"translations,omitempty" json:"translations,omitempty"`
+	CreatedAt    time.Time                   `bson:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time                   `bson:"updated_at" json:"updatedAt"`
+}
+
+func NewCardCategory(code, name string, limit int, translations map[string]CardCategoryText) *CardCategory {
+	return &CardCategory{
+		ID:           primitive.NewObjectID(),
+		Code:         code,
+		Name:         name,
+		Limit:        limit,
+		Translations: translations,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+func (c *CardCategory) Localize(name string) {
+	if text, ok := c.Translations[name]; ok && text.Name != "" {
+		c.Name = text.Name
+	}
+	c.Translations = nil
+}
+
+func (c *CardCategory) MissingLocales() []string {
+	return locale.Missing(func(name string) bool {
+		text, ok := c.Translations[name]
+		return ok && text.Name != ""
+	})
+}
+
+// CheckSelection makes sure the picked cards stay within the limit of their
+// category, or DefaultSelectionLimit for cards without one.
+func CheckSelection(cards []Card, categories []CardCategory) error {
+	limits := make(map[string]int, len(categories))
+	for _, category := range categories {
+		limits[category.Code] = category.Limit
+	}
+
+	counts := make(map[string]int)
+	for _, card := range cards {
+		counts[card.Category]++
+	}
+
+	for code, count := range counts {
+		limit, ok := limits[code]
+		if !ok {
+			limit = DefaultSelectionLimit
+		}
+		if count > limit {
+			if code == "" {
+				return fmt.Errorf("at most %d cards without a category can be picked", limit)
+			}
+			return fmt.Errorf("at most %d cards of category %s can be picked", limit, code)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package card
+
+import "errors"
+
+// ErrDuplicateCategoryCode is returned when another category uses the code.
+var ErrDuplicateCategoryCode = errors.New("duplicate code")
+
+type CardCategoryRepository interface {
+	CreateCardCategory(cardCategory *CardCategory) error
+	FindAllCardCategories() (*[]CardCategory, error)
+	FindCardCategoryById(id string) (*CardCategory, error)
+	FindCardCategoryByCode(code string) (*CardCategory, error)
+	UpdateCardCategoryById(id, name string, limit int, translations map[string]CardCategoryText) error
+}
@@ -1,11 +1,25 @@
 package card
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrCardHasRecords is returned when a card to remove has been picked.
+var ErrCardHasRecords = errors.New("card has records")
 
 type CardRepository interface {
 	CreateCard(card *Card) error
 	FindCardById(id string) (*Card, error)
-	FindAllCardByRole(page, limit int, isAdmin bool) (*[]Card, int, error)
+	FindAllCardByRole(page, limit int, isAdmin bool, filter CardFilter) (*[]Card, int, error)
+	FindCards() (*[]Card, error)
+	FindCardsByIds(ids []primitive.ObjectID) (*[]Card, error)
 	FindCardByIdAndActivate(id string) error
-	UpdateCardById(id, name, description string, image primitive.ObjectID) error
+	FindCardByIdAndDeactivate(id string) error
+	UpdateCardById(id, name, description string, image primitive.ObjectID, category string, tags []string, translations map[string]CardText) error
+	ReorderCards(ids []primitive.ObjectID) error
+	ArchiveCardById(id string, archivedAt *time.Time) error
+	RemoveCardById(id string) error
 }
@@ -0,0 +1,36 @@
+package counselling
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	AccessActionListParticipants = "LIST_PARTICIPANTS"
+	AccessActionViewRecords      = "VIEW_RECORDS"
+	AccessActionViewNotes        = "VIEW_NOTES"
+	AccessActionCreateNote       = "CREATE_NOTE"
+)
+
+// AccessLog records every attempt by a counsellor to reach participant data,
+// including the ones that were refused.
+type AccessLog struct {
+	ID         primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	Counsellor primitive.ObjectID  `bson:"counsellor" json:"counsellor"`
+	User       *primitive.ObjectID `bson:"user" json:"user"`
+	Action     string              `bson:"action" json:"action"`
+	Allowed    bool                `bson:"allowed" json:"allowed"`
+	CreatedAt  time.Time           `bson:"created_at" json:"createdAt"`
+}
+
+func NewAccessLog(counsellor primitive.ObjectID, user *primitive.ObjectID, action string, allowed bool) *AccessLog {
+	return &AccessLog{
+		ID:         primitive.NewObjectID(),
+		Counsellor: counsellor,
+		User:       user,
+		Action:     action,
+		Allowed:    allowed,
+		CreatedAt:  time.Now(),
+	}
+}
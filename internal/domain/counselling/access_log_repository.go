@@ -0,0 +1,8 @@
+package counselling
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type AccessLogRepository interface {
+	CreateAccessLog(accessLog *AccessLog) error
+	FindAccessLogs(counsellor *primitive.ObjectID, page, limit int) (*[]AccessLog, int, error)
+}
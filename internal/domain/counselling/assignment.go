@@ -0,0 +1,29 @@
+package counselling
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Assignment links a counsellor either to one participant or to every
+// participant of a cohort (group code).
+type Assignment struct {
+	ID         primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	Counsellor primitive.ObjectID  `bson:"counsellor" json:"counsellor"`
+	User       *primitive.ObjectID `bson:"user" json:"user"`
+	GroupCode  *string             `bson:"group_code" json:"groupCode"`
+	CreatedAt  time.Time           `bson:"created_at" json:"createdAt"`
+	UpdatedAt  time.Time           `bson:"updated_at" json:"updatedAt"`
+}
+
+func NewAssignment(counsellor primitive.ObjectID, user *primitive.ObjectID, groupCode *string) *Assignment {
+	return &Assignment{
+		ID:         primitive.NewObjectID(),
+		Counsellor: counsellor,
+		User:       user,
+		GroupCode:  groupCode,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
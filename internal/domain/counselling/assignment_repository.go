@@ -0,0 +1,18 @@
+package counselling
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ErrDuplicateAssignment is returned when the counsellor already has the
+// participant assigned.
+var ErrDuplicateAssignment = errors.New("duplicate assignment")
+
+type AssignmentRepository interface {
+	CreateAssignment(assignment *Assignment) error
+	FindAssignmentsByCounsellor(counsellor primitive.ObjectID) (*[]Assignment, error)
+	IsAssigned(counsellor, user primitive.ObjectID, groupCode *string) (bool, error)
+	RemoveAssignmentById(id string) error
+}
@@ -0,0 +1,28 @@
+package counselling
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CounsellorNote is only ever shown to the counsellor who wrote it.
+type CounsellorNote struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Counsellor primitive.ObjectID `bson:"counsellor" json:"counsellor"`
+	User       primitive.ObjectID `bson:"user" json:"user"`
+	Content    string             `bson:"content" json:"content"`
+	CreatedAt  time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt  time.Time          `bson:"updated_at" json:"updatedAt"`
+}
+
+func NewCounsellorNote(counsellor, user primitive.ObjectID, content string) *CounsellorNote {
+	return &CounsellorNote{
+		ID:         primitive.NewObjectID(),
+		Counsellor: counsellor,
+		User:       user,
+		Content:    content,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
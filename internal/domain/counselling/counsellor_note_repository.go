@@ -0,0 +1,9 @@
+package counselling
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type CounsellorNoteRepository interface {
+	CreateCounsellorNote(counsellorNote *CounsellorNote) error
+	FindCounsellorNotes(counsellor, user primitive.ObjectID) (*[]CounsellorNote, error)
+	RemoveDataByUserId(id string) error
+}
@@ -1,6 +1,7 @@
 package health_score
 
 import (
+	"mucb_be/internal/domain/locale"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -20,19 +21,36 @@ type HealthScoreContent struct {
 }
 
 type HealthScore struct {
-	ID             primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
-	Contents       []HealthScoreContent `bson:"contents" json:"contents"`
-	MaximumPercent int                  `bson:"maximum_percent" json:"maximumPercent"`
-	CreatedAt      time.Time            `bson:"created_at" json:"createdAt"`
-	UpdatedAt      time.Time            `bson:"updated_at" json:"updatedAt"`
+	ID             primitive.ObjectID              `bson:"_id,omitempty" json:"id"`
+	Contents       []HealthScoreContent            `bson:"contents" json:"contents"`
+	Translations   map[string][]HealthScoreContent `bson:"translations,omitempty" json:"translations,omitempty"`
+	MaximumPercent int                             `bson:"maximum_percent" json:"maximumPercent"`
+	CreatedAt      time.Time                       `bson:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time                       `bson:"updated_at" json:"updatedAt"`
 }
 
-func NewHealthScore(contents []HealthScoreContent, maximumPercent int) *HealthScore {
+func NewHealthScore(contents []HealthScoreContent, translations map[string][]HealthScoreContent, maximumPercent int) *HealthScore {
 	return &HealthScore{
 		ID:             primitive.NewObjectID(),
 		Contents:       contents,
+		Translations:   translations,
 		MaximumPercent: maximumPercent,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
 }
+
+// Localize swaps the whole content list, since a translation may need a
+// different layout than the default one.
+func (h *HealthScore) Localize(name string) {
+	if contents, ok := h.Translations[name]; ok && len(contents) > 0 {
+		h.Contents = contents
+	}
+	h.Translations = nil
+}
+
+func (h *HealthScore) MissingLocales() []string {
+	return locale.Missing(func(name string) bool {
+		return len(h.Translations[name]) > 0
+	})
+}
@@ -4,6 +4,6 @@ type HealthScoreRepository interface {
 	CreateHealthScore(healthScore *HealthScore) error
 	FindAllHealthScore() (*[]HealthScore, error)
 	FindHealthScoreById(id string) (*HealthScore, error)
-	UpdateHealthScoreById(id string, contents []HealthScoreContent, maximumPercent int) error
+	UpdateHealthScoreById(id string, contents []HealthScoreContent, translations map[string][]HealthScoreContent, maximumPercent int) error
 	FindContentByScore(score int) (*HealthScore, error)
 }
@@ -0,0 +1,42 @@
+package idempotency
+
+import (
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	StatusProcessing = "PROCESSING"
+	StatusCompleted  = "COMPLETED"
+)
+
+var ErrIdempotencyKeyExists = errors.New("idempotency key already exists")
+
+type IdempotencyKey struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	User           primitive.ObjectID `bson:"user" json:"user"`
+	Key            string             `bson:"key" json:"key"`
+	Endpoint       string             `bson:"endpoint" json:"endpoint"`
+	RequestHash    string             `bson:"request_hash" json:"requestHash"`
+	Status         string             `bson:"status" json:"status"`
+	ResponseStatus int                `bson:"response_status" json:"responseStatus"`
+	ResponseBody   string             `bson:"response_body" json:"responseBody"`
+	ContentType    string             `bson:"content_type" json:"contentType"`
+	CreatedAt      time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time          `bson:"updated_at" json:"updatedAt"`
+}
+
+func NewIdempotencyKey(user primitive.ObjectID, key, endpoint, requestHash string) *IdempotencyKey {
+	return &IdempotencyKey{
+		ID:          primitive.NewObjectID(),
+		User:        user,
+		Key:         key,
+		Endpoint:    endpoint,
+		RequestHash: requestHash,
+		Status:      StatusProcessing,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,10 @@
+package idempotency
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type IdempotencyKeyRepository interface {
+	CreateIdempotencyKey(idempotencyKey *IdempotencyKey) error
+	FindIdempotencyKey(user primitive.ObjectID, key string) (*IdempotencyKey, error)
+	CompleteIdempotencyKeyById(id string, responseStatus int, responseBody, contentType string) error
+	RemoveIdempotencyKeyById(id string) error
+}
@@ -1,11 +1,31 @@
 package image
 
 import (
+	"path"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const VariantOriginal = "original"
+
+const (
+	StatusActive   = "ACTIVE"
+	StatusInactive = "INACTIVE"
+	StatusArchived = "ARCHIVED"
+)
+
+// ImageVariant is a scaled down copy of an image stored under its own key.
+type ImageVariant struct {
+	Name        string `bson:"name" json:"name"`
+	Key         string `bson:"key" json:"key"`
+	Width       int    `bson:"width" json:"width"`
+	Height      int    `bson:"height" json:"height"`
+	ContentType string `bson:"content_type" json:"contentType"`
+	Hash        string `bson:"hash,omitempty" json:"hash,omitempty"`
+}
+
 type Image struct {
 	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
 	Path         string             `bson:"path" json:"path"`
@@ -14,12 +34,15 @@ type Image struct {
 	Width        int                `bson:"width" json:"width"`
 	Height       int                `bson:"height" json:"height"`
 	ContentType  string             `bson:"content_type" json:"contentType"`
+	Hash         string             `bson:"hash,omitempty" json:"hash,omitempty"`
+	Variants     []ImageVariant     `bson:"variants,omitempty" json:"variants,omitempty"`
 	IsActive     bool               `bson:"is_active" json:"isActive"`
+	ArchivedAt   *time.Time         `bson:"archived_at,omitempty" json:"archivedAt,omitempty"`
 	CreatedAt    time.Time          `bson:"created_at" json:"createdAt"`
 	UpdatedAt    time.Time          `bson:"updated_at" json:"updatedAt"`
 }
 
-func NewImage(path, name, originalName, contentType string, width, height int) *Image {
+func NewImage(path, name, originalName, contentType, hash string, width, height int) *Image {
 	return &Image{
 		ID:           primitive.NewObjectID(),
 		Path:         path,
@@ -28,8 +51,38 @@ func NewImage(path, name, originalName, contentType string, width, height int) *
 		Width:        width,
 		Height:       height,
 		ContentType:  contentType,
+		Hash:         hash,
 		IsActive:     false,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
 }
+
+// Status tells whether the image is shown somewhere, waiting to be used or
+// archived.
+func (i *Image) Status() string {
+	switch {
+	case i.ArchivedAt != nil:
+		return StatusArchived
+	case i.IsActive:
+		return StatusActive
+	default:
+		return StatusInactive
+	}
+}
+
+func (i *Image) Variant(name string) *ImageVariant {
+	for index := range i.Variants {
+		if i.Variants[index].Name == name {
+			return &i.Variants[index]
+		}
+	}
+	return nil
+}
+
+// VariantKey names the object of a variant after the original, so
+// "a1b2.png" gets "a1b2_thumbnail.png".
+func (i *Image) VariantKey(name string) string {
+	ext := path.Ext(i.Name)
+	return strings.TrimSuffix(i.Name, ext) + "_" + name + ext
+}
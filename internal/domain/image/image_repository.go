@@ -1,7 +1,25 @@
 package image
 
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrDuplicateHash is returned when another image that is not archived
+	// has the same content hash.
+	ErrDuplicateHash = errors.New("duplicate hash")
+	// ErrImageInUse is returned when a card or health score shows an image
+	// that is to be removed.
+	ErrImageInUse = errors.New("image is in use")
+)
+
 type ImageRepository interface {
 	CreateImage(image *Image) error
 	FindImageByID(id string) (*Image, error)
+	FindImageByHash(hash string) (*Image, error)
 	UpdateImageStatusById(id string, currentStats bool) error
+	ArchiveImageById(id string, archivedAt *time.Time) error
+	RemoveImageById(id string) error
+	AddImageVariant(id string, variant ImageVariant) error
 }
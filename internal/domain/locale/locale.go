@@ -0,0 +1,105 @@
+package locale
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	Thai    = "th"
+	English = "en"
+)
+
+// Default is the locale the base fields of every entity are written in. The
+// translations of an entity only hold the other locales.
+const Default = Thai
+
+var Supported = []string{
+	Thai,
+	English,
+}
+
+func IsSupported(name string) bool {
+	for _, supported := range Supported {
+		if supported == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that every translation is keyed by a supported locale
+// other than Default.
+func Validate[T any](translations map[string]T) error {
+	for name := range translations {
+		if name == Default {
+			return fmt.Errorf("%s is the default locale and is set on the base fields", name)
+		}
+		if !IsSupported(name) {
+			return fmt.Errorf("unsupported locale %s", name)
+		}
+	}
+	return nil
+}
+
+// Resolve picks the locale for a response from the Accept-Language header,
+// then the participant's saved preference, then Default.
+func Resolve(acceptLanguage, preferred string) string {
+	type candidate struct {
+		name    string
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		if name == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if value, ok := strings.CutPrefix(param, "q="); ok {
+				parsed, err := strconv.ParseFloat(value, 64)
+				if err == nil {
+					quality = parsed
+				}
+			}
+		}
+
+		// ✅ th-TH and en-US match their base language
+		name, _, _ = strings.Cut(name, "-")
+		if quality > 0 && IsSupported(name) {
+			candidates = append(candidates, candidate{name: name, quality: quality})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	if len(candidates) > 0 {
+		return candidates[0].name
+	}
+
+	if IsSupported(preferred) {
+		return preferred
+	}
+
+	return Default
+}
+
+// Missing lists the supported locales, other than Default, for which
+// hasText reports no complete translation.
+func Missing(hasText func(name string) bool) []string {
+	missing := make([]string, 0)
+	for _, name := range Supported {
+		if name != Default && !hasText(name) {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
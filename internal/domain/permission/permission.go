@@ -0,0 +1,139 @@
+package permission
+
+import (
+	"mucb_be/internal/domain/admin"
+	"mucb_be/internal/domain/user"
+)
+
+const (
+	AdminWrite        = "admin:write"
+	PermissionWrite   = "permission:write"
+	QuestionRead      = "question:read"
+	QuestionWrite     = "question:write"
+	QuestionPublish   = "question:publish"
+	CardRead          = "card:read"
+	CardManage        = "card:manage"
+	CardWrite         = "card:write"
+	CardActivate      = "card:activate"
+	CardStatsRead     = "card:stats"
+	ImageUpload       = "image:upload"
+	HealthScoreRead   = "health_score:read"
+	HealthScoreWrite  = "health_score:write"
+	RiskRead          = "risk:read"
+	RiskWrite         = "risk:write"
+	CounsellingAssign = "counselling:assign"
+	CounsellingAudit  = "counselling:audit"
+	CounsellingAccess = "counselling:access"
+	ProfileManage     = "profile:manage"
+	ExamTake          = "exam:take"
+	RecordSubmit      = "record:submit"
+	RecordHistory     = "record:history"
+	AuditRead         = "audit:read"
+	TranslationRead   = "translation:read"
+)
+
+// All lists every permission the API checks, so role mappings can be
+// validated before they are stored.
+var All = []string{
+	AdminWrite,
+	PermissionWrite,
+	QuestionRead,
+	QuestionWrite,
+	QuestionPublish,
+	CardRead,
+	CardManage,
+	CardWrite,
+	CardActivate,
+	CardStatsRead,
+	ImageUpload,
+	HealthScoreRead,
+	HealthScoreWrite,
+	RiskRead,
+	RiskWrite,
+	CounsellingAssign,
+	CounsellingAudit,
+	CounsellingAccess,
+	ProfileManage,
+	ExamTake,
+	RecordSubmit,
+	RecordHistory,
+	AuditRead,
+	TranslationRead,
+}
+
+var Roles = []string{
+	admin.RoleSuperAdmin,
+	admin.RoleAdmin,
+	admin.RoleCounsellor,
+	user.RoleUser,
+}
+
+// DefaultRolePermissions seeds the role mappings on first start and matches
+// the access the role lists in the router used to grant.
+var DefaultRolePermissions = map[string][]string{
+	admin.RoleSuperAdmin: {
+		AdminWrite,
+		PermissionWrite,
+		QuestionRead,
+		QuestionWrite,
+		QuestionPublish,
+		CardRead,
+		CardManage,
+		CardWrite,
+		CardActivate,
+		CardStatsRead,
+		ImageUpload,
+		HealthScoreRead,
+		HealthScoreWrite,
+		RiskRead,
+		RiskWrite,
+		CounsellingAssign,
+		CounsellingAudit,
+		AuditRead,
+		TranslationRead,
+	},
+	admin.RoleAdmin: {
+		QuestionRead,
+		QuestionWrite,
+		QuestionPublish,
+		CardRead,
+		CardManage,
+		CardWrite,
+		CardActivate,
+		CardStatsRead,
+		ImageUpload,
+		HealthScoreRead,
+		HealthScoreWrite,
+		RiskRead,
+		RiskWrite,
+		TranslationRead,
+	},
+	admin.RoleCounsellor: {
+		CounsellingAccess,
+	},
+	user.RoleUser: {
+		ProfileManage,
+		ExamTake,
+		RecordSubmit,
+		RecordHistory,
+		CardRead,
+	},
+}
+
+func IsValid(name string) bool {
+	for _, permission := range All {
+		if permission == name {
+			return true
+		}
+	}
+	return false
+}
+
+func IsValidRole(role string) bool {
+	for _, name := range Roles {
+		if name == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package permission
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RolePermission is the editable permission set of a role. Known lists the
+// permissions that existed when the role was last seeded, so permissions
+// added in a later release can be granted by default without restoring
+// ones a super admin removed on purpose.
+type RolePermission struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Role        string             `bson:"role" json:"role"`
+	Permissions []string           `bson:"permissions" json:"permissions"`
+	Known       []string           `bson:"known_permissions" json:"-"`
+	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt   time.Time          `bson:"updated_at" json:"updatedAt"`
+}
+
+func NewRolePermission(role string, permissions []string) *RolePermission {
+	return &RolePermission{
+		ID:          primitive.NewObjectID(),
+		Role:        role,
+		Permissions: permissions,
+		Known:       All,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
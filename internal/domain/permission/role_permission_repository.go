@@ -0,0 +1,6 @@
+package permission
+
+type RolePermissionRepository interface {
+	FindAllRolePermissions() (*[]RolePermission, error)
+	UpdatePermissionsByRole(role string, permissions []string) error
+}
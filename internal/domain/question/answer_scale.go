@@ -0,0 +1,166 @@
+package question
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	ScaleTypeLikert = "LIKERT"
+	ScaleTypeYesNo  = "YES_NO"
+	ScaleTypeSlider = "SLIDER"
+)
+
+var ErrInvalidAnswer = errors.New("answer does not fit the question group")
+
+type ScalePoint struct {
+	Value int    `bson:"value" json:"value"`
+	Label string `bson:"label" json:"label" binding:"required,max=64"`
+}
+
+// AnswerScale describes the values a participant can pick for every choice of
+// a question group, e.g. a 0–3 frequency scale, a labelled 1–5 Likert scale,
+// yes/no as 0/1 or a slider between Min and Max moving by Step.
+type AnswerScale struct {
+	Type   string       `bson:"type" json:"type" binding:"required,oneof=LIKERT YES_NO SLIDER"`
+	Min    int          `bson:"min" json:"min"`
+	Max    int          `bson:"max" json:"max"`
+	Step   int          `bson:"step,omitempty" json:"step,omitempty" binding:"min=0"`
+	Points []ScalePoint `bson:"points,omitempty" json:"points,omitempty" binding:"omitempty,max=11,dive"`
+}
+
+type ChoiceAnswer struct {
+	Choice primitive.ObjectID `bson:"choice" json:"choice"`
+	Value  int                `bson:"value" json:"value"`
+}
+
+func (s *AnswerScale) Validate() error {
+	switch s.Type {
+	case ScaleTypeLikert, ScaleTypeYesNo, ScaleTypeSlider:
+	default:
+		return fmt.Errorf("unknown scale type %q", s.Type)
+	}
+
+	if s.Min >= s.Max {
+		return fmt.Errorf("min must be lower than max")
+	}
+
+	if s.Type == ScaleTypeYesNo && (s.Min != 0 || s.Max != 1) {
+		return fmt.Errorf("yes/no scale must use 0 and 1")
+	}
+
+	if s.Type == ScaleTypeSlider {
+		if s.Step <= 0 {
+			return fmt.Errorf("slider step must be positive")
+		}
+		if (s.Max-s.Min)%s.Step != 0 {
+			return fmt.Errorf("slider range must be a multiple of step")
+		}
+	}
+
+	seen := make(map[int]bool, len(s.Points))
+	for _, point := range s.Points {
+		if point.Value < s.Min || point.Value > s.Max {
+			return fmt.Errorf("point %d is outside the scale", point.Value)
+		}
+		if seen[point.Value] {
+			return fmt.Errorf("point %d is duplicated", point.Value)
+		}
+		seen[point.Value] = true
+	}
+
+	return nil
+}
+
+// Contains reports whether a participant could have picked the value. When a
+// Likert scale lists its points, only those points are accepted.
+func (s *AnswerScale) Contains(value int) bool {
+	if value < s.Min || value > s.Max {
+		return false
+	}
+
+	if s.Type == ScaleTypeSlider {
+		return (value-s.Min)%s.Step == 0
+	}
+
+	if s.Type == ScaleTypeLikert && len(s.Points) > 0 {
+		for _, point := range s.Points {
+			if point.Value == value {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// Score returns the points a value is worth, reversing it for inverted
+// choices so that the highest answer scores the lowest.
+func (s *AnswerScale) Score(value int, invert bool) int {
+	if invert {
+		return s.Max + s.Min - value
+	}
+	return value
+}
+
+// ScoreAnswers validates the values given for the choices of this revision
+// against its scale and returns the group score. Every pinned choice and as
+// many choices as an exam of this revision serves must be answered, so a
+// client can not leave out the choices that would raise the score.
+func (r *QuestionRevision) ScoreAnswers(answers []ChoiceAnswer) (int, error) {
+	if r.Scale == nil {
+		return 0, fmt.Errorf("%w: question group has no answer scale", ErrInvalidAnswer)
+	}
+
+	choices := make(map[primitive.ObjectID]ChoiceRevision, len(r.Choices))
+	for _, choice := range r.Choices {
+		choices[choice.Choice] = choice
+	}
+
+	score := 0
+	answered := make(map[primitive.ObjectID]bool, len(answers))
+	for _, answer := range answers {
+		choice, ok := choices[answer.Choice]
+		if !ok || answered[answer.Choice] {
+			return 0, fmt.Errorf("%w: unexpected choice %s", ErrInvalidAnswer, answer.Choice.Hex())
+		}
+		answered[answer.Choice] = true
+
+		if !r.Scale.Contains(answer.Value) {
+			return 0, fmt.Errorf("%w: value %d is outside the scale", ErrInvalidAnswer, answer.Value)
+		}
+
+		score += r.Scale.Score(answer.Value, choice.ShouldInvert)
+	}
+
+	for _, choice := range r.Choices {
+		if choice.Pinned && !answered[choice.Choice] {
+			return 0, fmt.Errorf("%w: pinned choice %s is not answered", ErrInvalidAnswer, choice.Choice.Hex())
+		}
+	}
+
+	if served := r.ServedChoices(); len(answers) != served {
+		return 0, fmt.Errorf("%w: %d of %d served choices answered", ErrInvalidAnswer, len(answers), served)
+	}
+
+	return score, nil
+}
+
+// Answer scores one group answer. Groups with a scale are scored from the
+// values picked for each served choice; groups without one only carry a
+// score and the number of questions.
+func (r *QuestionRevision) Answer(values []ChoiceAnswer, score, size int) (int, int, error) {
+	if r.Scale == nil && len(values) == 0 {
+		return score, size, nil
+	}
+
+	score, err := r.ScoreAnswers(values)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return score, len(values), nil
+}
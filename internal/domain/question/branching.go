@@ -0,0 +1,175 @@
+package question
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	ConditionScore   = "SCORE"
+	ConditionAnswer  = "ANSWER"
+	ConditionProfile = "PROFILE"
+)
+
+const (
+	OperatorGreaterThan      = "GT"
+	OperatorGreaterThanEqual = "GTE"
+	OperatorLessThan         = "LT"
+	OperatorLessThanEqual    = "LTE"
+	OperatorEqual            = "EQ"
+	OperatorNotEqual         = "NE"
+	OperatorIn               = "IN"
+	OperatorNotIn            = "NOT_IN"
+)
+
+const (
+	ProfileGroupCode = "GROUP_CODE"
+	ProfileLanguage  = "LANGUAGE"
+)
+
+var ErrConditionNotMet = errors.New("question group is skipped by its conditions")
+
+// Condition is one rule a question group needs to be shown. SCORE compares
+// the score of another group, ANSWER the value picked for a choice, so a
+// group conditioned on a choice acts as its follow-up, and PROFILE matches
+// the participant's group code or language against Values.
+type Condition struct {
+	Type          string              `bson:"type" json:"type" binding:"required,oneof=SCORE ANSWER PROFILE"`
+	QuestionGroup *primitive.ObjectID `bson:"question_group,omitempty" json:"questionGroup,omitempty"`
+	Choice        *primitive.ObjectID `bson:"choice,omitempty" json:"choice,omitempty"`
+	Field         string              `bson:"field,omitempty" json:"field,omitempty" binding:"omitempty,oneof=GROUP_CODE LANGUAGE"`
+	Operator      string              `bson:"operator" json:"operator" binding:"required,oneof=GT GTE LT LTE EQ NE IN NOT_IN"`
+	Value         int                 `bson:"value" json:"value"`
+	Values        []string            `bson:"values,omitempty" json:"values,omitempty" binding:"omitempty,max=50"`
+}
+
+type Profile struct {
+	GroupCode string
+	Language  string
+}
+
+func NewProfile(groupCode *string, language string) Profile {
+	profile := Profile{Language: language}
+	if groupCode != nil {
+		profile.GroupCode = *groupCode
+	}
+	return profile
+}
+
+// BranchState is what a participant has answered so far in one sitting.
+type BranchState struct {
+	Profile Profile
+	Scores  map[primitive.ObjectID]int
+	Answers map[primitive.ObjectID]int
+}
+
+func NewBranchState(profile Profile) *BranchState {
+	return &BranchState{
+		Profile: profile,
+		Scores:  make(map[primitive.ObjectID]int),
+		Answers: make(map[primitive.ObjectID]int),
+	}
+}
+
+func (s *BranchState) Answer(questionGroup primitive.ObjectID, score int, values []ChoiceAnswer) {
+	s.Scores[questionGroup] = score
+	for _, value := range values {
+		s.Answers[value.Choice] = value.Value
+	}
+}
+
+func (c *Condition) Validate() error {
+	switch c.Type {
+	case ConditionScore:
+		if c.QuestionGroup == nil {
+			return fmt.Errorf("score condition needs a question group")
+		}
+		return checkNumericOperator(c.Operator)
+	case ConditionAnswer:
+		if c.Choice == nil {
+			return fmt.Errorf("answer condition needs a choice")
+		}
+		return checkNumericOperator(c.Operator)
+	case ConditionProfile:
+		if c.Field != ProfileGroupCode && c.Field != ProfileLanguage {
+			return fmt.Errorf("unknown profile field %q", c.Field)
+		}
+		if c.Operator != OperatorIn && c.Operator != OperatorNotIn {
+			return fmt.Errorf("profile condition needs IN or NOT_IN")
+		}
+		if len(c.Values) == 0 {
+			return fmt.Errorf("profile condition needs values")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown condition type %q", c.Type)
+	}
+}
+
+func checkNumericOperator(operator string) error {
+	switch operator {
+	case OperatorGreaterThan, OperatorGreaterThanEqual, OperatorLessThan, OperatorLessThanEqual, OperatorEqual, OperatorNotEqual:
+		return nil
+	default:
+		return fmt.Errorf("operator %s does not compare numbers", operator)
+	}
+}
+
+// Holds reports whether the condition is met. Conditions on a group or
+// choice that has not been answered are not met.
+func (c *Condition) Holds(state *BranchState) bool {
+	switch c.Type {
+	case ConditionScore:
+		score, ok := state.Scores[*c.QuestionGroup]
+		return ok && compare(score, c.Operator, c.Value)
+	case ConditionAnswer:
+		value, ok := state.Answers[*c.Choice]
+		return ok && compare(value, c.Operator, c.Value)
+	case ConditionProfile:
+		field := state.Profile.GroupCode
+		if c.Field == ProfileLanguage {
+			field = state.Profile.Language
+		}
+		found := false
+		for _, value := range c.Values {
+			if value == field {
+				found = true
+				break
+			}
+		}
+		return found == (c.Operator == OperatorIn)
+	default:
+		return false
+	}
+}
+
+func compare(left int, operator string, right int) bool {
+	switch operator {
+	case OperatorGreaterThan:
+		return left > right
+	case OperatorGreaterThanEqual:
+		return left >= right
+	case OperatorLessThan:
+		return left < right
+	case OperatorLessThanEqual:
+		return left <= right
+	case OperatorEqual:
+		return left == right
+	case OperatorNotEqual:
+		return left != right
+	default:
+		return false
+	}
+}
+
+// Eligible reports whether every condition of the revision holds.
+func (r *QuestionRevision) Eligible(state *BranchState) bool {
+	for i := range r.Conditions {
+		if !r.Conditions[i].Holds(state) {
+			return false
+		}
+	}
+	return true
+}
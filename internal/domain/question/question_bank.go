@@ -0,0 +1,444 @@
+package question
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mucb_be/internal/domain/locale"
+	"mucb_be/internal/domain/schedule"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// QuestionBank is the portable form of every question group and its choices.
+// Ids are kept so that an exported bank can be imported again, into the same
+// database or another one, without duplicating what already exists.
+type QuestionBank struct {
+	Groups []QuestionBankGroup `json:"groups"`
+}
+
+type QuestionBankGroup struct {
+	ID           string                       `json:"id,omitempty"`
+	ColumnName   string                       `json:"columnName"`
+	Description  string                       `json:"description"`
+	Limit        int                          `json:"limit"`
+	Schedule     *schedule.Schedule           `json:"schedule,omitempty"`
+	Scale        *AnswerScale                 `json:"scale,omitempty"`
+	Sampling     *Sampling                    `json:"sampling,omitempty"`
+	Conditions   []Condition                  `json:"conditions,omitempty"`
+	Translations map[string]QuestionGroupText `json:"translations,omitempty"`
+	Choices      []QuestionBankChoice         `json:"choices"`
+	Row          string                       `json:"-"`
+}
+
+type QuestionBankChoice struct {
+	ID           string                        `json:"id,omitempty"`
+	Question     string                        `json:"question"`
+	ShouldInvert bool                          `json:"shouldInvert"`
+	Pinned       bool                          `json:"pinned,omitempty"`
+	Position     int                           `json:"position,omitempty"`
+	Translations map[string]QuestionChoiceText `json:"translations,omitempty"`
+	Row          string                        `json:"-"`
+}
+
+// QuestionBankError points at the row of an import that can not be applied.
+type QuestionBankError struct {
+	Row     string `json:"row"`
+	Message string `json:"message"`
+}
+
+func NewQuestionBankGroup(questionGroup *QuestionGroup, choices []QuestionChoice) QuestionBankGroup {
+	group := QuestionBankGroup{
+		ID:           questionGroup.ID.Hex(),
+		ColumnName:   questionGroup.ColumnName,
+		Description:  questionGroup.Description,
+		Limit:        questionGroup.Limit,
+		Schedule:     questionGroup.Schedule,
+		Scale:        questionGroup.Scale,
+		Sampling:     questionGroup.Sampling,
+		Conditions:   questionGroup.Conditions,
+		Translations: questionGroup.Translations,
+		Choices:      make([]QuestionBankChoice, 0, len(choices)),
+	}
+
+	for _, choice := range choices {
+		group.Choices = append(group.Choices, QuestionBankChoice{
+			ID:           choice.ID.Hex(),
+			Question:     choice.Question,
+			ShouldInvert: choice.ShouldInvert,
+			Pinned:       choice.Pinned,
+			Position:     choice.Position,
+			Translations: choice.Translations,
+		})
+	}
+
+	return group
+}
+
+// Validate applies the rules of /question/create-group. Schedules are left
+// to the schedule service.
+func (g *QuestionBankGroup) Validate() error {
+	if err := checkText("columnName", g.ColumnName, 64); err != nil {
+		return err
+	}
+	if err := checkText("description", g.Description, 256); err != nil {
+		return err
+	}
+	if g.Limit < 1 || g.Limit > 10 {
+		return fmt.Errorf("limit must be between 1 and 10")
+	}
+
+	if g.Scale != nil {
+		if err := g.Scale.Validate(); err != nil {
+			return fmt.Errorf("scale: %w", err)
+		}
+	}
+
+	if g.Sampling != nil {
+		if err := g.Sampling.Validate(); err != nil {
+			return fmt.Errorf("sampling: %w", err)
+		}
+	}
+
+	for i := range g.Conditions {
+		if err := g.Conditions[i].Validate(); err != nil {
+			return fmt.Errorf("conditions[%d]: %w", i, err)
+		}
+	}
+
+	if err := locale.Validate(g.Translations); err != nil {
+		return err
+	}
+	for name, text := range g.Translations {
+		if err := checkText(name+".columnName", text.ColumnName, 64); err != nil {
+			return err
+		}
+		if err := checkText(name+".description", text.Description, 256); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *QuestionBankChoice) Validate() error {
+	if err := checkText("question", c.Question, 256); err != nil {
+		return err
+	}
+	if c.Position < 0 {
+		return fmt.Errorf("position must not be negative")
+	}
+
+	if err := locale.Validate(c.Translations); err != nil {
+		return err
+	}
+	for name, text := range c.Translations {
+		if err := checkText(name+".question", text.Question, 256); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkText(field, value string, max int) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+	if utf8.RuneCountInString(value) > max {
+		return fmt.Errorf("%s must be at most %d characters", field, max)
+	}
+	return nil
+}
+
+// The CSV form has one row per choice. Group columns are read from the first
+// row of each group, rows are grouped by group_id or, without one, by
+// column_name, and a row without a question only declares its group.
+// Schedules, scales, sampling and conditions are written as JSON inside their
+// cells.
+const (
+	csvGroupID      = "group_id"
+	csvColumnName   = "column_name"
+	csvDescription  = "description"
+	csvLimit        = "limit"
+	csvSchedule     = "schedule"
+	csvScale        = "scale"
+	csvSampling     = "sampling"
+	csvConditions   = "conditions"
+	csvChoiceID     = "choice_id"
+	csvQuestion     = "question"
+	csvShouldInvert = "should_invert"
+	csvPinned       = "pinned"
+	csvPosition     = "position"
+)
+
+func questionBankCSVHeader() []string {
+	header := []string{csvGroupID, csvColumnName, csvDescription, csvLimit, csvSchedule, csvScale, csvSampling, csvConditions, csvChoiceID, csvQuestion, csvShouldInvert, csvPinned, csvPosition}
+	for _, name := range translatedLocales() {
+		header = append(header, csvColumnName+"_"+name, csvDescription+"_"+name, csvQuestion+"_"+name)
+	}
+	return header
+}
+
+func translatedLocales() []string {
+	names := make([]string, 0, len(locale.Supported))
+	for _, name := range locale.Supported {
+		if name != locale.Default {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func WriteQuestionBankCSV(w io.Writer, bank *QuestionBank) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(questionBankCSVHeader()); err != nil {
+		return err
+	}
+
+	for _, group := range bank.Groups {
+		groupSchedule, err := jsonCell(group.Schedule, group.Schedule != nil)
+		if err != nil {
+			return err
+		}
+		scale, err := jsonCell(group.Scale, group.Scale != nil)
+		if err != nil {
+			return err
+		}
+		sampling, err := jsonCell(group.Sampling, group.Sampling != nil)
+		if err != nil {
+			return err
+		}
+		conditions, err := jsonCell(group.Conditions, len(group.Conditions) > 0)
+		if err != nil {
+			return err
+		}
+
+		groupColumns := []string{group.ID, group.ColumnName, group.Description, strconv.Itoa(group.Limit), groupSchedule, scale, sampling, conditions}
+
+		// ✅ A group without choices still needs a row to survive the round trip
+		choices := group.Choices
+		if len(choices) == 0 {
+			choices = []QuestionBankChoice{{}}
+		}
+
+		for _, choice := range choices {
+			row := append([]string{}, groupColumns...)
+			shouldInvert, pinned, position := "", "", ""
+			if choice.Question != "" {
+				shouldInvert = strconv.FormatBool(choice.ShouldInvert)
+				pinned = strconv.FormatBool(choice.Pinned)
+				position = strconv.Itoa(choice.Position)
+			}
+			row = append(row, choice.ID, choice.Question, shouldInvert, pinned, position)
+			for _, name := range translatedLocales() {
+				groupText := group.Translations[name]
+				choiceText := choice.Translations[name]
+				row = append(row, groupText.ColumnName, groupText.Description, choiceText.Question)
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func jsonCell(value interface{}, present bool) (string, error) {
+	if !present {
+		return "", nil
+	}
+
+	cell, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(cell), nil
+}
+
+// ReadQuestionBankCSV parses a bank written by WriteQuestionBankCSV. Cells
+// that can not be parsed are reported per row so that the rest of the file
+// can still be checked.
+func ReadQuestionBankCSV(r io.Reader) (*QuestionBank, []QuestionBankError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("can not read header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+	for _, name := range []string{csvColumnName, csvDescription, csvLimit, csvQuestion} {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("missing column %s", name)
+		}
+	}
+
+	bank := &QuestionBank{Groups: make([]QuestionBankGroup, 0)}
+	groups := make(map[string]int)
+	rowErrors := make([]QuestionBankError, 0)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, nil, err
+			}
+			rowErrors = append(rowErrors, QuestionBankError{Row: "line " + strconv.Itoa(parseErr.StartLine), Message: parseErr.Err.Error()})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+		row := "line " + strconv.Itoa(line)
+
+		cell := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		key := cell(csvGroupID)
+		if key == "" {
+			key = "column_name:" + cell(csvColumnName)
+		}
+
+		index, ok := groups[key]
+		if !ok {
+			group, err := readCSVGroup(cell)
+			if err != nil {
+				rowErrors = append(rowErrors, QuestionBankError{Row: row, Message: err.Error()})
+				continue
+			}
+			group.Row = row
+			bank.Groups = append(bank.Groups, *group)
+			index = len(bank.Groups) - 1
+			groups[key] = index
+		}
+
+		if cell(csvQuestion) == "" && cell(csvChoiceID) == "" {
+			continue
+		}
+
+		choice, err := readCSVChoice(cell)
+		if err != nil {
+			rowErrors = append(rowErrors, QuestionBankError{Row: row, Message: err.Error()})
+			continue
+		}
+		choice.Row = row
+		bank.Groups[index].Choices = append(bank.Groups[index].Choices, *choice)
+	}
+
+	return bank, rowErrors, nil
+}
+
+func readCSVGroup(cell func(string) string) (*QuestionBankGroup, error) {
+	group := QuestionBankGroup{
+		ID:          cell(csvGroupID),
+		ColumnName:  cell(csvColumnName),
+		Description: cell(csvDescription),
+		Choices:     make([]QuestionBankChoice, 0),
+	}
+
+	limit, err := strconv.Atoi(cell(csvLimit))
+	if err != nil {
+		return nil, fmt.Errorf("limit must be a number")
+	}
+	group.Limit = limit
+
+	if value := cell(csvSchedule); value != "" {
+		group.Schedule = &schedule.Schedule{}
+		if err := json.Unmarshal([]byte(value), group.Schedule); err != nil {
+			return nil, fmt.Errorf("schedule must be JSON: %w", err)
+		}
+	}
+
+	if value := cell(csvScale); value != "" {
+		group.Scale = &AnswerScale{}
+		if err := json.Unmarshal([]byte(value), group.Scale); err != nil {
+			return nil, fmt.Errorf("scale must be JSON: %w", err)
+		}
+	}
+
+	if value := cell(csvSampling); value != "" {
+		group.Sampling = &Sampling{}
+		if err := json.Unmarshal([]byte(value), group.Sampling); err != nil {
+			return nil, fmt.Errorf("sampling must be JSON: %w", err)
+		}
+	}
+
+	if value := cell(csvConditions); value != "" {
+		if err := json.Unmarshal([]byte(value), &group.Conditions); err != nil {
+			return nil, fmt.Errorf("conditions must be JSON: %w", err)
+		}
+	}
+
+	for _, name := range translatedLocales() {
+		text := QuestionGroupText{
+			ColumnName:  cell(csvColumnName + "_" + name),
+			Description: cell(csvDescription + "_" + name),
+		}
+		if text.ColumnName != "" || text.Description != "" {
+			if group.Translations == nil {
+				group.Translations = make(map[string]QuestionGroupText)
+			}
+			group.Translations[name] = text
+		}
+	}
+
+	return &group, nil
+}
+
+func readCSVChoice(cell func(string) string) (*QuestionBankChoice, error) {
+	choice := QuestionBankChoice{
+		ID:       cell(csvChoiceID),
+		Question: cell(csvQuestion),
+	}
+
+	if value := cell(csvShouldInvert); value != "" {
+		shouldInvert, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("should_invert must be true or false")
+		}
+		choice.ShouldInvert = shouldInvert
+	}
+
+	if value := cell(csvPinned); value != "" {
+		pinned, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("pinned must be true or false")
+		}
+		choice.Pinned = pinned
+	}
+
+	if value := cell(csvPosition); value != "" {
+		position, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("position must be a number")
+		}
+		choice.Position = position
+	}
+
+	for _, name := range translatedLocales() {
+		if value := cell(csvQuestion + "_" + name); value != "" {
+			if choice.Translations == nil {
+				choice.Translations = make(map[string]QuestionChoiceText)
+			}
+			choice.Translations[name] = QuestionChoiceText{Question: value}
+		}
+	}
+
+	return &choice, nil
+}
@@ -0,0 +1,26 @@
+package question
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrQuestionGroupHasRecords is returned when a group to remove has
+	// been answered.
+	ErrQuestionGroupHasRecords = errors.New("question group has records")
+	// ErrQuestionGroupInUse is returned when a group to remove is part of a
+	// questionnaire.
+	ErrQuestionGroupInUse = errors.New("question group is used by a questionnaire")
+	// ErrQuestionChoiceHasRecords is returned when a choice to remove has
+	// been answered.
+	ErrQuestionChoiceHasRecords = errors.New("question choice has records")
+)
+
+type QuestionBankRepository interface {
+	ImportQuestionBank(questionGroups []QuestionGroup, questionChoices []QuestionChoice) error
+	PublishQuestionGroup(questionRevision *QuestionRevision) error
+	RemoveQuestionGroup(questionGroup primitive.ObjectID) error
+	RemoveQuestionChoice(questionGroup, questionChoice primitive.ObjectID) error
+}
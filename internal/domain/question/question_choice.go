@@ -1,27 +1,55 @@
 package question
 
 import (
+	"mucb_be/internal/domain/locale"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+type QuestionChoiceText struct {
+	Question string `bson:"question" json:"question" binding:"required,max=256"`
+}
+
 type QuestionChoice struct {
-	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	QuestionGroup primitive.ObjectID `bson:"question_group" json:"questionGroup"`
-	Question      string             `bson:"question" json:"question"`
-	ShouldInvert  bool               `bson:"should_invert" json:"shouldInvert"`
-	CreatedAt     time.Time          `bson:"created_at" json:"createdAt"`
-	UpdatedAt     time.Time          `bson:"updated_at" json:"updatedAt"`
+	ID            primitive.ObjectID            `bson:"_id,omitempty" json:"id"`
+	QuestionGroup primitive.ObjectID            `bson:"question_group" json:"questionGroup"`
+	Question      string                        `bson:"question" json:"question"`
+	ShouldInvert  bool                          `bson:"should_invert" json:"shouldInvert"`
+	Pinned        bool                          `bson:"pinned" json:"pinned"`
+	Position      int                           `bson:"position" json:"position"`
+	Translations  map[string]QuestionChoiceText `bson:"translations,omitempty" json:"translations,omitempty"`
+	Draft         bool                          `bson:"draft" json:"draft"`
+	ArchivedAt    *time.Time                    `bson:"archived_at,omitempty" json:"archivedAt,omitempty"`
+	CreatedAt     time.Time                     `bson:"created_at" json:"createdAt"`
+	UpdatedAt     time.Time                     `bson:"updated_at" json:"updatedAt"`
 }
 
-func NewQuestionChoice(questionGroup primitive.ObjectID, question string, shouldInvert bool) *QuestionChoice {
+func NewQuestionChoice(questionGroup primitive.ObjectID, question string, shouldInvert, pinned bool, position int, translations map[string]QuestionChoiceText) *QuestionChoice {
 	return &QuestionChoice{
 		ID:            primitive.NewObjectID(),
 		QuestionGroup: questionGroup,
 		Question:      question,
 		ShouldInvert:  shouldInvert,
+		Pinned:        pinned,
+		Position:      position,
+		Translations:  translations,
+		Draft:         true,
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
 }
+
+func (c *QuestionChoice) Localize(name string) {
+	if text, ok := c.Translations[name]; ok && text.Question != "" {
+		c.Question = text.Question
+	}
+	c.Translations = nil
+}
+
+func (c *QuestionChoice) MissingLocales() []string {
+	return locale.Missing(func(name string) bool {
+		text, ok := c.Translations[name]
+		return ok && text.Question != ""
+	})
+}
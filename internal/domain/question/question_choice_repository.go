@@ -1,11 +1,16 @@
 package question
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
 
 type QuestionChoiceRepository interface {
 	CreateQuestionChoice(questionChoice *QuestionChoice) error
-	FindAllQuestionChoiceByQuestionGroup(questionGroup *primitive.ObjectID) (*[]QuestionChoice, error)
-	UpdateQuestionChoiceById(id, question string, shouldInvert bool) error
-	RemoveChoiceById(id string) error
-	RemoveChoicesByQuestionGroupId(id string) error
+	FindAllQuestionChoiceByQuestionGroup(questionGroup *primitive.ObjectID, archived bool) (*[]QuestionChoice, error)
+	FindQuestionChoiceById(id string) (*QuestionChoice, error)
+	FindQuestionChoices() (*[]QuestionChoice, error)
+	UpdateQuestionChoiceById(id, question string, shouldInvert, pinned bool, position int, translations map[string]QuestionChoiceText) error
+	ArchiveChoiceById(id string, archivedAt *time.Time) error
 }
@@ -1,18 +1,34 @@
 package question
 
 import (
+	"mucb_be/internal/domain/locale"
+	"mucb_be/internal/domain/schedule"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+type QuestionGroupText struct {
+	ColumnName  string `bson:"column_name" json:"columnName" binding:"required,max=64"`
+	Description string `bson:"description" json:"description" binding:"required,max=256"`
+}
+
 type QuestionGroup struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	ColumnName  string             `bson:"column_name" json:"columnName"`
-	Description string             `bson:"description" json:"description"`
-	Limit       int                `bson:"limit" json:"limit"`
-	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
-	UpdatedAt   time.Time          `bson:"updated_at" json:"updatedAt"`
+	ID           primitive.ObjectID           `bson:"_id,omitempty" json:"id"`
+	ColumnName   string                       `bson:"column_name" json:"columnName"`
+	Description  string                       `bson:"description" json:"description"`
+	Limit        int                          `bson:"limit" json:"limit"`
+	Schedule     *schedule.Schedule           `bson:"schedule,omitempty" json:"schedule,omitempty"`
+	Scale        *AnswerScale                 `bson:"scale,omitempty" json:"scale,omitempty"`
+	Sampling     *Sampling                    `bson:"sampling,omitempty" json:"sampling,omitempty"`
+	Conditions   []Condition                  `bson:"conditions,omitempty" json:"conditions,omitempty"`
+	Translations map[string]QuestionGroupText `bson:"translations,omitempty" json:"translations,omitempty"`
+	Revision     primitive.ObjectID           `bson:"revision,omitempty" json:"revision"`
+	Version      int                          `bson:"version" json:"version"`
+	Draft        bool                         `bson:"draft" json:"draft"`
+	ArchivedAt   *time.Time                   `bson:"archived_at,omitempty" json:"archivedAt,omitempty"`
+	CreatedAt    time.Time                    `bson:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time                    `bson:"updated_at" json:"updatedAt"`
 }
 
 type GroupsWithRandomChoices struct {
@@ -20,13 +36,46 @@ type GroupsWithRandomChoices struct {
 	Choices       []QuestionChoice `bson:"choices" json:"choices"`
 }
 
-func NewQuestionGroup(columnName, description string, limit int) *QuestionGroup {
+func NewQuestionGroup(columnName, description string, limit int, groupSchedule *schedule.Schedule, scale *AnswerScale, sampling *Sampling, translations map[string]QuestionGroupText) *QuestionGroup {
 	return &QuestionGroup{
-		ID:          primitive.NewObjectID(),
-		ColumnName:  columnName,
-		Description: description,
-		Limit:       limit,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		ID:           primitive.NewObjectID(),
+		ColumnName:   columnName,
+		Description:  description,
+		Limit:        limit,
+		Schedule:     groupSchedule,
+		Scale:        scale,
+		Sampling:     sampling,
+		Translations: translations,
+		Draft:        true,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// Localize replaces the base texts with the given locale where a translation
+// exists and drops the translations from the response.
+func (g *QuestionGroup) Localize(name string) {
+	if text, ok := g.Translations[name]; ok {
+		if text.ColumnName != "" {
+			g.ColumnName = text.ColumnName
+		}
+		if text.Description != "" {
+			g.Description = text.Description
+		}
+	}
+	g.Translations = nil
+}
+
+func (g *QuestionGroup) MissingLocales() []string {
+	return locale.Missing(func(name string) bool {
+		text, ok := g.Translations[name]
+		return ok && text.ColumnName != "" && text.Description != ""
+	})
+}
+
+func (g *GroupsWithRandomChoices) Localize(name string) {
+	g.QuestionGroup.Localize(name)
+	for i := range g.Choices {
+		g.Choices[i].Localize(name)
 	}
 }
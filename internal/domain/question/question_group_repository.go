@@ -1,10 +1,17 @@
 package question
 
+import (
+	"mucb_be/internal/domain/schedule"
+	"time"
+)
+
 type QuestionGroupRepository interface {
 	CreateQuestionGroup(questionGroup *QuestionGroup) error
-	FindAllQuestionGroup(page, limit int) (*[]QuestionGroup, int, error)
-	FindGroupsWithRandomChoices() (*[]GroupsWithRandomChoices, error)
-	RemoveQuestionGroupById(id string) error
+	FindAllQuestionGroup(page, limit int, archived bool) (*[]QuestionGroup, int, error)
+	FindQuestionGroups() (*[]QuestionGroup, error)
 	FindQuestionGroupById(id string) (*QuestionGroup, error)
-	UpdateQuestionGroupById(id, columnName, description string, limit int) error
+	UpdateQuestionGroupById(id, columnName, description string, limit int, groupSchedule *schedule.Schedule, scale *AnswerScale, sampling *Sampling, translations map[string]QuestionGroupText) error
+	UpdateQuestionGroupConditions(id string, conditions []Condition) error
+	MarkQuestionGroupDraft(id string) error
+	ArchiveQuestionGroupById(id string, archivedAt *time.Time) error
 }
@@ -0,0 +1,186 @@
+package question
+
+import (
+	"mucb_be/internal/domain/schedule"
+	"reflect"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ChoiceRevision struct {
+	Choice       primitive.ObjectID            `bson:"choice" json:"choice"`
+	Question     string                        `bson:"question" json:"question"`
+	ShouldInvert bool                          `bson:"should_invert" json:"shouldInvert"`
+	Pinned       bool                          `bson:"pinned" json:"pinned"`
+	Position     int                           `bson:"position" json:"position"`
+	Translations map[string]QuestionChoiceText `bson:"translations,omitempty" json:"translations,omitempty"`
+}
+
+// QuestionRevision is an immutable snapshot of a question group and its
+// choices, created when a draft is published. Participants see the latest
+// revision whose PublishedAt has passed, so a publish can be scheduled by
+// giving it a future PublishedAt. Records keep the revision they were
+// answered against, so later edits never change what a participant was shown.
+type QuestionRevision struct {
+	ID            primitive.ObjectID           `bson:"_id,omitempty" json:"id"`
+	QuestionGroup primitive.ObjectID           `bson:"question_group" json:"questionGroup"`
+	Version       int                          `bson:"version" json:"version"`
+	ColumnName    string                       `bson:"column_name" json:"columnName"`
+	Description   string                       `bson:"description" json:"description"`
+	Limit         int                          `bson:"limit" json:"limit"`
+	Schedule      *schedule.Schedule           `bson:"schedule,omitempty" json:"schedule,omitempty"`
+	Scale         *AnswerScale                 `bson:"scale,omitempty" json:"scale,omitempty"`
+	Sampling      *Sampling                    `bson:"sampling,omitempty" json:"sampling,omitempty"`
+	Conditions    []Condition                  `bson:"conditions,omitempty" json:"conditions,omitempty"`
+	Translations  map[string]QuestionGroupText `bson:"translations,omitempty" json:"translations,omitempty"`
+	Choices       []ChoiceRevision             `bson:"choices" json:"choices"`
+	PublishedAt   time.Time                    `bson:"published_at" json:"publishedAt"`
+	CreatedAt     time.Time                    `bson:"created_at" json:"createdAt"`
+}
+
+func NewQuestionRevision(questionGroup *QuestionGroup, choices []QuestionChoice, version int, publishedAt time.Time) *QuestionRevision {
+	choiceRevisions := make([]ChoiceRevision, 0, len(choices))
+	for _, choice := range choices {
+		choiceRevisions = append(choiceRevisions, ChoiceRevision{
+			Choice:       choice.ID,
+			Question:     choice.Question,
+			ShouldInvert: choice.ShouldInvert,
+			Pinned:       choice.Pinned,
+			Position:     choice.Position,
+			Translations: choice.Translations,
+		})
+	}
+
+	// ✅ Choices are kept in display order for fixed ordering and round-robin
+	sort.SliceStable(choiceRevisions, func(i, j int) bool {
+		return choiceRevisions[i].Position < choiceRevisions[j].Position
+	})
+
+	return &QuestionRevision{
+		ID:            primitive.NewObjectID(),
+		QuestionGroup: questionGroup.ID,
+		Version:       version,
+		ColumnName:    questionGroup.ColumnName,
+		Description:   questionGroup.Description,
+		Limit:         questionGroup.Limit,
+		Schedule:      questionGroup.Schedule,
+		Scale:         questionGroup.Scale,
+		Sampling:      questionGroup.Sampling,
+		Conditions:    questionGroup.Conditions,
+		Translations:  questionGroup.Translations,
+		Choices:       choiceRevisions,
+		PublishedAt:   publishedAt,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// Snapshot returns the question group as this revision presents it.
+func (r *QuestionRevision) Snapshot() QuestionGroup {
+	return QuestionGroup{
+		ID:           r.QuestionGroup,
+		ColumnName:   r.ColumnName,
+		Description:  r.Description,
+		Limit:        r.Limit,
+		Schedule:     r.Schedule,
+		Scale:        r.Scale,
+		Sampling:     r.Sampling,
+		Conditions:   r.Conditions,
+		Translations: r.Translations,
+		Revision:     r.ID,
+		Version:      r.Version,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.CreatedAt,
+	}
+}
+
+type FieldChange struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+type ChoiceChange struct {
+	Choice primitive.ObjectID `json:"choice"`
+	Before ChoiceRevision     `json:"before"`
+	After  ChoiceRevision     `json:"after"`
+}
+
+type RevisionDiff struct {
+	QuestionGroup  primitive.ObjectID `json:"questionGroup"`
+	From           int                `json:"from"`
+	To             int                `json:"to"`
+	Fields         []FieldChange      `json:"fields"`
+	AddedChoices   []ChoiceRevision   `json:"addedChoices"`
+	RemovedChoices []ChoiceRevision   `json:"removedChoices"`
+	ChangedChoices []ChoiceChange     `json:"changedChoices"`
+}
+
+// DiffRevisions compares two revisions of the same question group. Choices
+// are matched by id, so rewording a question shows up as a change rather than
+// a removal plus an addition.
+func DiffRevisions(from, to *QuestionRevision) *RevisionDiff {
+	diff := &RevisionDiff{
+		QuestionGroup:  to.QuestionGroup,
+		From:           from.Version,
+		To:             to.Version,
+		Fields:         make([]FieldChange, 0),
+		AddedChoices:   make([]ChoiceRevision, 0),
+		RemovedChoices: make([]ChoiceRevision, 0),
+		ChangedChoices: make([]ChoiceChange, 0),
+	}
+
+	fields := []struct {
+		name   string
+		before interface{}
+		after  interface{}
+	}{
+		{"columnName", from.ColumnName, to.ColumnName},
+		{"description", from.Description, to.Description},
+		{"limit", from.Limit, to.Limit},
+		{"schedule", from.Schedule, to.Schedule},
+		{"scale", from.Scale, to.Scale},
+		{"sampling", from.Sampling, to.Sampling},
+		{"conditions", from.Conditions, to.Conditions},
+		{"translations", from.Translations, to.Translations},
+	}
+	for _, field := range fields {
+		if !reflect.DeepEqual(field.before, field.after) {
+			diff.Fields = append(diff.Fields, FieldChange{
+				Field:  field.name,
+				Before: field.before,
+				After:  field.after,
+			})
+		}
+	}
+
+	previous := make(map[primitive.ObjectID]ChoiceRevision, len(from.Choices))
+	for _, choice := range from.Choices {
+		previous[choice.Choice] = choice
+	}
+
+	for _, choice := range to.Choices {
+		before, ok := previous[choice.Choice]
+		if !ok {
+			diff.AddedChoices = append(diff.AddedChoices, choice)
+			continue
+		}
+		if !reflect.DeepEqual(before, choice) {
+			diff.ChangedChoices = append(diff.ChangedChoices, ChoiceChange{
+				Choice: choice.Choice,
+				Before: before,
+				After:  choice,
+			})
+		}
+		delete(previous, choice.Choice)
+	}
+
+	for _, choice := range from.Choices {
+		if _, ok := previous[choice.Choice]; ok {
+			diff.RemovedChoices = append(diff.RemovedChoices, choice)
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,15 @@
+package question
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type QuestionRevisionRepository interface {
+	FindQuestionRevisionById(id string) (*QuestionRevision, error)
+	FindQuestionRevisionByVersion(questionGroup primitive.ObjectID, version int) (*QuestionRevision, error)
+	FindQuestionRevisionsByQuestionGroup(questionGroup primitive.ObjectID) (*[]QuestionRevision, error)
+	FindLiveQuestionRevision(questionGroup primitive.ObjectID, at time.Time) (*QuestionRevision, error)
+	FindLiveQuestionRevisions(at time.Time) (*[]QuestionRevision, error)
+}
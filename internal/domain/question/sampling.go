@@ -0,0 +1,156 @@
+package question
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+const (
+	SamplingUniform    = "UNIFORM"
+	SamplingRoundRobin = "ROUND_ROBIN"
+	SamplingSeeded     = "SEEDED"
+)
+
+const (
+	ChoiceOrderShuffled = "SHUFFLED"
+	ChoiceOrderFixed    = "FIXED"
+)
+
+// Sampling decides which choices of a group an exam shows and in which
+// order. UNIFORM draws at random on every request, ROUND_ROBIN walks through
+// the choices one exam after another so each participant eventually sees all
+// of them, and SEEDED draws the same set for a participant for the whole day.
+// FIXED keeps the choices in their position order, SHUFFLED mixes them.
+type Sampling struct {
+	Strategy string `bson:"strategy" json:"strategy" binding:"required,oneof=UNIFORM ROUND_ROBIN SEEDED"`
+	Order    string `bson:"order" json:"order" binding:"required,oneof=SHUFFLED FIXED"`
+}
+
+var DefaultSampling = Sampling{
+	Strategy: SamplingUniform,
+	Order:    ChoiceOrderShuffled,
+}
+
+func (s *Sampling) Validate() error {
+	switch s.Strategy {
+	case SamplingUniform, SamplingRoundRobin, SamplingSeeded:
+	default:
+		return fmt.Errorf("unknown sampling strategy %q", s.Strategy)
+	}
+
+	switch s.Order {
+	case ChoiceOrderShuffled, ChoiceOrderFixed:
+	default:
+		return fmt.Errorf("unknown choice order %q", s.Order)
+	}
+
+	return nil
+}
+
+// ChoiceDraw carries what a strategy needs to know about the participant.
+// Seed identifies the participant, group and day, and Served is how many
+// times the participant has already answered the group.
+type ChoiceDraw struct {
+	Seed   int64
+	Served int
+}
+
+// NewGroupsWithRandomChoices draws the exam choices of a revision. Pinned
+// choices are always shown, even beyond the limit, and the remaining places
+// are filled by the group's sampling strategy. The exam and the admin preview
+// both go through here so they render the same way.
+func NewGroupsWithRandomChoices(questionRevision *QuestionRevision, draw ChoiceDraw) GroupsWithRandomChoices {
+	sampling := DefaultSampling
+	if questionRevision.Sampling != nil {
+		sampling = *questionRevision.Sampling
+	}
+
+	random := rand.New(rand.NewSource(rand.Int63()))
+	if sampling.Strategy != SamplingUniform && draw.Seed != 0 {
+		random = rand.New(rand.NewSource(draw.Seed))
+	}
+
+	pinned := make([]int, 0)
+	rest := make([]int, 0, len(questionRevision.Choices))
+	for i, choice := range questionRevision.Choices {
+		if choice.Pinned {
+			pinned = append(pinned, i)
+		} else {
+			rest = append(rest, i)
+		}
+	}
+
+	size := sampleSize(questionRevision.Limit, len(pinned), len(rest))
+
+	picked := make([]int, 0, size)
+	switch sampling.Strategy {
+	case SamplingRoundRobin:
+		if size > 0 {
+			start := (draw.Served * size) % len(rest)
+			for i := 0; i < size; i++ {
+				picked = append(picked, rest[(start+i)%len(rest)])
+			}
+		}
+	default:
+		random.Shuffle(len(rest), func(i, j int) {
+			rest[i], rest[j] = rest[j], rest[i]
+		})
+		picked = append(picked, rest[:size]...)
+	}
+
+	selected := append(pinned, picked...)
+	if sampling.Order == ChoiceOrderFixed {
+		sort.Ints(selected)
+	} else {
+		random.Shuffle(len(selected), func(i, j int) {
+			selected[i], selected[j] = selected[j], selected[i]
+		})
+	}
+
+	choices := make([]QuestionChoice, 0, len(selected))
+	for _, i := range selected {
+		choice := questionRevision.Choices[i]
+		choices = append(choices, QuestionChoice{
+			ID:            choice.Choice,
+			QuestionGroup: questionRevision.QuestionGroup,
+			Question:      choice.Question,
+			ShouldInvert:  choice.ShouldInvert,
+			Pinned:        choice.Pinned,
+			Position:      choice.Position,
+			Translations:  choice.Translations,
+			CreatedAt:     questionRevision.CreatedAt,
+			UpdatedAt:     questionRevision.CreatedAt,
+		})
+	}
+
+	return GroupsWithRandomChoices{
+		QuestionGroup: questionRevision.Snapshot(),
+		Choices:       choices,
+	}
+}
+
+// ServedChoices returns how many choices an exam of the revision shows.
+func (r *QuestionRevision) ServedChoices() int {
+	pinned := 0
+	for _, choice := range r.Choices {
+		if choice.Pinned {
+			pinned++
+		}
+	}
+
+	return pinned + sampleSize(r.Limit, pinned, len(r.Choices)-pinned)
+}
+
+// sampleSize is the number of unpinned choices drawn next to the pinned ones.
+func sampleSize(limit, pinned, rest int) int {
+	size := limit - pinned
+	if rest < size {
+		size = rest
+	}
+	if size < 0 {
+		size = 0
+	}
+
+	return size
+}
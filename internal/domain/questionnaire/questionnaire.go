@@ -0,0 +1,126 @@
+package questionnaire
+
+import (
+	"mucb_be/internal/domain/question"
+	"mucb_be/internal/domain/schedule"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	ScoringSum  = "SUM"
+	ScoringMean = "MEAN"
+)
+
+type ScoreBand struct {
+	Min   float64 `bson:"min" json:"min"`
+	Max   float64 `bson:"max" json:"max"`
+	Label string  `bson:"label" json:"label" binding:"required,max=64"`
+}
+
+type Scoring struct {
+	Method string      `bson:"method" json:"method" binding:"required,oneof=SUM MEAN"`
+	Bands  []ScoreBand `bson:"bands" json:"bands" binding:"dive"`
+}
+
+// Questionnaire is a named instrument, such as a daily mood check or PSS,
+// made of question groups answered together in the given order.
+type Questionnaire struct {
+	ID             primitive.ObjectID   `bson:"_id,omitempty" json:"id"`
+	Name           string               `bson:"name" json:"name"`
+	Description    string               `bson:"description" json:"description"`
+	QuestionGroups []primitive.ObjectID `bson:"question_groups" json:"questionGroups"`
+	Schedule       *schedule.Schedule   `bson:"schedule,omitempty" json:"schedule,omitempty"`
+	Scoring        Scoring              `bson:"scoring" json:"scoring"`
+	Active         bool                 `bson:"active" json:"active"`
+	CreatedAt      time.Time            `bson:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time            `bson:"updated_at" json:"updatedAt"`
+}
+
+type Result struct {
+	Questionnaire primitive.ObjectID `json:"questionnaire"`
+	Total         float64            `json:"total"`
+	Band          string             `json:"band,omitempty"`
+}
+
+func NewQuestionnaire(
+	name, description string,
+	questionGroups []primitive.ObjectID,
+	questionnaireSchedule *schedule.Schedule,
+	scoring Scoring,
+	active bool,
+) *Questionnaire {
+	return &Questionnaire{
+		ID:             primitive.NewObjectID(),
+		Name:           name,
+		Description:    description,
+		QuestionGroups: questionGroups,
+		Schedule:       questionnaireSchedule,
+		Scoring:        scoring,
+		Active:         active,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+func (q *Questionnaire) Contains(questionGroup primitive.ObjectID) bool {
+	for _, id := range q.QuestionGroups {
+		if id == questionGroup {
+			return true
+		}
+	}
+	return false
+}
+
+// Score applies the scoring rule to the group scores of one submission and
+// labels the total with the first band that contains it.
+func (q *Questionnaire) Score(groupScores []int) *Result {
+	var total float64
+	for _, score := range groupScores {
+		total += float64(score)
+	}
+	if q.Scoring.Method == ScoringMean && len(groupScores) > 0 {
+		total = total / float64(len(groupScores))
+	}
+
+	result := &Result{
+		Questionnaire: q.ID,
+		Total:         total,
+	}
+	for _, band := range q.Scoring.Bands {
+		if total >= band.Min && total <= band.Max {
+			result.Band = band.Label
+			break
+		}
+	}
+
+	return result
+}
+
+// NextGroup walks the questionnaire in order and returns the first group
+// that has not been answered yet and whose conditions hold, together with
+// the groups passed over because their conditions do not hold. Groups
+// without a live revision are left out. It returns nil when nothing is left.
+func (q *Questionnaire) NextGroup(
+	revisions map[primitive.ObjectID]*question.QuestionRevision,
+	answered map[primitive.ObjectID]bool,
+	state *question.BranchState,
+) (*question.QuestionRevision, []primitive.ObjectID) {
+	skipped := make([]primitive.ObjectID, 0)
+	for _, questionGroup := range q.QuestionGroups {
+		revision, ok := revisions[questionGroup]
+		if !ok || answered[questionGroup] {
+			continue
+		}
+
+		if !revision.Eligible(state) {
+			skipped = append(skipped, questionGroup)
+			continue
+		}
+
+		return revision, skipped
+	}
+
+	return nil, skipped
+}
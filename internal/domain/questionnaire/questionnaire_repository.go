@@ -0,0 +1,22 @@
+package questionnaire
+
+import (
+	"mucb_be/internal/domain/schedule"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type QuestionnaireRepository interface {
+	CreateQuestionnaire(questionnaire *Questionnaire) error
+	FindAllQuestionnaire(page, limit int) (*[]Questionnaire, int, error)
+	FindActiveQuestionnaires() (*[]Questionnaire, error)
+	FindQuestionnaireById(id string) (*Questionnaire, error)
+	UpdateQuestionnaireById(
+		id, name, description string,
+		questionGroups []primitive.ObjectID,
+		questionnaireSchedule *schedule.Schedule,
+		scoring Scoring,
+		active bool,
+	) error
+	RemoveQuestionnaireById(id string) error
+}
@@ -7,21 +7,27 @@ import (
 )
 
 type CardRecord struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	User      primitive.ObjectID `bson:"user" json:"user"`
-	Card      primitive.ObjectID `bson:"card" json:"card"`
-	GroupCode *string            `bson:"group_code" json:"groupCode"`
-	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
-	UpdatedAt time.Time          `bson:"updated_at" json:"updatedAt"`
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	User       primitive.ObjectID `bson:"user" json:"user"`
+	Card       primitive.ObjectID `bson:"card" json:"card"`
+	CardName   string             `bson:"card_name" json:"cardName"`
+	GroupCode  *string            `bson:"group_code" json:"groupCode"`
+	Timezone   string             `bson:"timezone" json:"timezone"`
+	ReceivedAt time.Time          `bson:"received_at" json:"receivedAt"`
+	CreatedAt  time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt  time.Time          `bson:"updated_at" json:"updatedAt"`
 }
 
-func NewCardRecord(groupCode *string, user, card primitive.ObjectID, timestamp time.Time) *CardRecord {
+func NewCardRecord(groupCode *string, user, card primitive.ObjectID, cardName string, timestamp, receivedAt time.Time, timezone string) *CardRecord {
 	return &CardRecord{
-		ID:        primitive.NewObjectID(),
-		User:      user,
-		Card:      card,
-		GroupCode: groupCode,
-		CreatedAt: timestamp,
-		UpdatedAt: timestamp,
+		ID:         primitive.NewObjectID(),
+		User:       user,
+		Card:       card,
+		CardName:   cardName,
+		GroupCode:  groupCode,
+		Timezone:   timezone,
+		ReceivedAt: receivedAt,
+		CreatedAt:  timestamp,
+		UpdatedAt:  timestamp,
 	}
 }
@@ -1,9 +1,18 @@
 package record
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
 
 type CardRecordRepository interface {
 	CreateManyGroupRecord(cardRecords *[]CardRecord) error
-	HasSubmittedToday(user primitive.ObjectID) (bool, error)
+	HasSubmittedBetween(user primitive.ObjectID, start, end time.Time) (bool, error)
+	FindCardRecordsByUser(user primitive.ObjectID, limit int64) (*[]CardRecord, error)
 	RemoveDataByUserId(id string) error
+	CountDailyRecords(user primitive.ObjectID, start, end time.Time, timezone string) (map[string]int, error)
+	CountCardPicks(filter CardStatisticsFilter) ([]CardPickCount, error)
+	CountCardPairs(filter CardStatisticsFilter, limit int) ([]CardPairCount, error)
+	CountUserCardPicks(user primitive.ObjectID, filter CardStatisticsFilter) ([]CardPickCount, error)
 }
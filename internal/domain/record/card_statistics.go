@@ -0,0 +1,50 @@
+package record
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	IntervalDay   = "DAY"
+	IntervalWeek  = "WEEK"
+	IntervalMonth = "MONTH"
+)
+
+// PeriodFormats are the $dateToString formats used to bucket records by
+// interval. Weeks follow ISO 8601 so a week never spans two labels.
+var PeriodFormats = map[string]string{
+	IntervalDay:   "%Y-%m-%d",
+	IntervalWeek:  "%G-W%V",
+	IntervalMonth: "%Y-%m",
+}
+
+// CardStatisticsFilter limits the card records that are aggregated to
+// [Start, End), optionally to one cohort, and buckets them by Interval in
+// Timezone.
+type CardStatisticsFilter struct {
+	Start     time.Time
+	End       time.Time
+	Interval  string
+	Timezone  string
+	GroupCode *string
+}
+
+// CardPickCount is how many times a card was picked in one period by one
+// cohort. CardName is the name the card had on its latest pick.
+type CardPickCount struct {
+	Card      primitive.ObjectID `bson:"card" json:"card"`
+	CardName  string             `bson:"card_name" json:"cardName"`
+	Period    string             `bson:"period" json:"period"`
+	GroupCode *string            `bson:"group_code" json:"groupCode"`
+	Count     int                `bson:"count" json:"count"`
+}
+
+// CardPairCount is how many submissions picked both cards together. First
+// always sorts before Second so each pair is counted once.
+type CardPairCount struct {
+	First  primitive.ObjectID `bson:"first" json:"first"`
+	Second primitive.ObjectID `bson:"second" json:"second"`
+	Count  int                `bson:"count" json:"count"`
+}
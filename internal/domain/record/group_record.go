@@ -1,30 +1,39 @@
 package record
 
 import (
+	"mucb_be/internal/domain/question"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type GroupRecord struct {
-	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	User          primitive.ObjectID `bson:"user" json:"user"`
-	QuestionGroup primitive.ObjectID `bson:"question_group" json:"questionGroup"`
-	Score         int                `bson:"score" json:"score"`
-	Size          int                `bson:"question_size" json:"questionSize"`
-	GroupCode     *string            `bson:"group_code" json:"groupCode"`
-	CreatedAt     time.Time          `bson:"created_at" json:"createdAt"`
-	UpdatedAt     time.Time          `bson:"updated_at" json:"updatedAt"`
+	ID            primitive.ObjectID      `bson:"_id,omitempty" json:"id"`
+	User          primitive.ObjectID      `bson:"user" json:"user"`
+	QuestionGroup primitive.ObjectID      `bson:"question_group" json:"questionGroup"`
+	Revision      *primitive.ObjectID     `bson:"revision,omitempty" json:"revision,omitempty"`
+	Questionnaire *primitive.ObjectID     `bson:"questionnaire,omitempty" json:"questionnaire,omitempty"`
+	Score         int                     `bson:"score" json:"score"`
+	Size          int                     `bson:"question_size" json:"questionSize"`
+	Answers       []question.ChoiceAnswer `bson:"answers,omitempty" json:"answers,omitempty"`
+	GroupCode     *string                 `bson:"group_code" json:"groupCode"`
+	Timezone      string                  `bson:"timezone" json:"timezone"`
+	ReceivedAt    time.Time               `bson:"received_at" json:"receivedAt"`
+	CreatedAt     time.Time               `bson:"created_at" json:"createdAt"`
+	UpdatedAt     time.Time               `bson:"updated_at" json:"updatedAt"`
 }
 
-func NewGroupRecord(groupCode *string, user, questionGroup primitive.ObjectID, score, size int, timestamp time.Time) *GroupRecord {
+func NewGroupRecord(groupCode *string, user, questionGroup primitive.ObjectID, revision *primitive.ObjectID, score, size int, timestamp, receivedAt time.Time, timezone string) *GroupRecord {
 	return &GroupRecord{
 		ID:            primitive.NewObjectID(),
 		User:          user,
 		QuestionGroup: questionGroup,
+		Revision:      revision,
 		Score:         score,
 		Size:          size,
 		GroupCode:     groupCode,
+		Timezone:      timezone,
+		ReceivedAt:    receivedAt,
 		CreatedAt:     timestamp,
 		UpdatedAt:     timestamp,
 	}
@@ -1,11 +1,17 @@
 package record
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type GroupRecordRepository interface {
 	CreateManyGroupRecord(questionGroup *[]GroupRecord) error
-	HasSubmittedToday(user primitive.ObjectID) (bool, error)
+	HasSubmittedBetween(user, questionGroup primitive.ObjectID, start, end time.Time) (bool, error)
+	CountGroupRecords(user, questionGroup primitive.ObjectID) (int, error)
+	HasSubmittedQuestionnaireBetween(user, questionnaire primitive.ObjectID, start, end time.Time) (bool, error)
+	FindGroupRecordsByUser(user primitive.ObjectID, limit int64) (*[]GroupRecord, error)
 	RemoveDataByUserId(id string) error
+	CountDailyRecords(user primitive.ObjectID, start, end time.Time, timezone string) (map[string]int, error)
 }
@@ -7,21 +7,29 @@ import (
 )
 
 type StoryRecord struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	User      primitive.ObjectID `bson:"user" json:"user"`
-	Content   string             `bson:"content" json:"content"`
-	GroupCode *string            `bson:"group_code" json:"groupCode"`
-	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
-	UpdatedAt time.Time          `bson:"updated_at" json:"updatedAt"`
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	User         primitive.ObjectID `bson:"user" json:"user"`
+	Content      string             `bson:"content" json:"content"`
+	GroupCode    *string            `bson:"group_code" json:"groupCode"`
+	RiskScore    int                `bson:"risk_score" json:"riskScore"`
+	RiskKeywords []string           `bson:"risk_keywords" json:"riskKeywords"`
+	Flagged      bool               `bson:"flagged" json:"flagged"`
+	RiskSeverity string             `bson:"risk_severity,omitempty" json:"riskSeverity,omitempty"`
+	Timezone     string             `bson:"timezone" json:"timezone"`
+	ReceivedAt   time.Time          `bson:"received_at" json:"receivedAt"`
+	CreatedAt    time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time          `bson:"updated_at" json:"updatedAt"`
 }
 
-func NewStoryRecord(groupCode *string, user primitive.ObjectID, content string) *StoryRecord {
+func NewStoryRecord(groupCode *string, user primitive.ObjectID, content string, timestamp, receivedAt time.Time, timezone string) *StoryRecord {
 	return &StoryRecord{
-		ID:        primitive.NewObjectID(),
-		User:      user,
-		Content:   content,
-		GroupCode: groupCode,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:         primitive.NewObjectID(),
+		User:       user,
+		Content:    content,
+		GroupCode:  groupCode,
+		Timezone:   timezone,
+		ReceivedAt: receivedAt,
+		CreatedAt:  timestamp,
+		UpdatedAt:  timestamp,
 	}
 }
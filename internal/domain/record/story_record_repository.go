@@ -1,6 +1,15 @@
 package record
 
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
 type StoryRecordRepository interface {
 	CreateStoryRecord(storyRecord *StoryRecord) error
+	FindFlaggedStoryRecords(limit int64) (*[]StoryRecord, error)
+	FindFlaggedStoryRecordsByUser(user primitive.ObjectID, limit int64) (*[]StoryRecord, error)
 	RemoveDataByUserId(id string) error
+	CountDailyRecords(user primitive.ObjectID, start, end time.Time, timezone string) (map[string]int, error)
 }
@@ -0,0 +1,40 @@
+package record
+
+import (
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	SubmissionTypeGroup         = "GROUP"
+	SubmissionTypeCard          = "CARD"
+	SubmissionTypeStory         = "STORY"
+	SubmissionTypeQuestionnaire = "QUESTIONNAIRE"
+)
+
+var ErrAlreadySubmitted = errors.New("already submitted for this window")
+
+// SubmissionSlot is the single marker written per user, submission type,
+// target and schedule window. A unique index on these fields stops concurrent
+// or retried submissions from both passing the availability check.
+type SubmissionSlot struct {
+	ID             primitive.ObjectID  `bson:"_id,omitempty" json:"id"`
+	User           primitive.ObjectID  `bson:"user" json:"user"`
+	SubmissionType string              `bson:"submission_type" json:"submissionType"`
+	Target         *primitive.ObjectID `bson:"target" json:"target"`
+	SlotKey        string              `bson:"slot_key" json:"slotKey"`
+	CreatedAt      time.Time           `bson:"created_at" json:"createdAt"`
+}
+
+func NewSubmissionSlot(user primitive.ObjectID, submissionType string, target *primitive.ObjectID, slotKey string) *SubmissionSlot {
+	return &SubmissionSlot{
+		ID:             primitive.NewObjectID(),
+		User:           user,
+		SubmissionType: submissionType,
+		Target:         target,
+		SlotKey:        slotKey,
+		CreatedAt:      time.Now(),
+	}
+}
@@ -0,0 +1,7 @@
+package record
+
+type SubmissionSlotRepository interface {
+	CreateManySubmissionSlot(submissionSlots *[]SubmissionSlot) error
+	RemoveManySubmissionSlotById(ids []string) error
+	RemoveDataByUserId(id string) error
+}
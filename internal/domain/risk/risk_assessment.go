@@ -0,0 +1,38 @@
+package risk
+
+const (
+	SeverityLow      = "LOW"
+	SeverityHigh     = "HIGH"
+	SeverityCritical = "CRITICAL"
+)
+
+// Assessment is the outcome of screening a piece of free text against the
+// configured keyword lists.
+type Assessment struct {
+	Score    int      `json:"score"`
+	Keywords []string `json:"keywords"`
+	Flagged  bool     `json:"flagged"`
+	Severity string   `json:"severity"`
+}
+
+type Hotline struct {
+	Name  string `json:"name"`
+	Phone string `json:"phone"`
+}
+
+type SupportContent struct {
+	Title    string    `json:"title"`
+	Message  string    `json:"message"`
+	Hotlines []Hotline `json:"hotlines"`
+}
+
+// DefaultSupportContent is shown to a participant whose entry was flagged.
+var DefaultSupportContent = SupportContent{
+	Title:   "You are not alone",
+	Message: "It sounds like you are going through a lot right now. Talking to someone can help, and support is available any time of day.",
+	Hotlines: []Hotline{
+		{Name: "Department of Mental Health Hotline", Phone: "1323"},
+		{Name: "Samaritans of Thailand", Phone: "02-113-6789"},
+		{Name: "Emergency Medical Services", Phone: "1669"},
+	},
+}
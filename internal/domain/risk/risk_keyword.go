@@ -0,0 +1,32 @@
+package risk
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	LanguageThai    = "th"
+	LanguageEnglish = "en"
+)
+
+type RiskKeyword struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Phrase    string             `bson:"phrase" json:"phrase"`
+	Language  string             `bson:"language" json:"language"`
+	Weight    int                `bson:"weight" json:"weight"`
+	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updatedAt"`
+}
+
+func NewRiskKeyword(phrase, language string, weight int) *RiskKeyword {
+	return &RiskKeyword{
+		ID:        primitive.NewObjectID(),
+		Phrase:    phrase,
+		Language:  language,
+		Weight:    weight,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,15 @@
+package risk
+
+import "errors"
+
+// ErrDuplicatePhrase is returned when the phrase is already a keyword in
+// the language.
+var ErrDuplicatePhrase = errors.New("duplicate phrase")
+
+type RiskKeywordRepository interface {
+	CreateRiskKeyword(riskKeyword *RiskKeyword) error
+	FindAllRiskKeywords() (*[]RiskKeyword, error)
+	FindRiskKeywordById(id string) (*RiskKeyword, error)
+	UpdateRiskKeywordById(id, phrase, language string, weight int) error
+	RemoveRiskKeywordById(id string) error
+}
@@ -0,0 +1,19 @@
+package schedule
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	ActivityCard = "CARD"
+)
+
+type ActivitySchedule struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Activity  string             `bson:"activity" json:"activity"`
+	Schedule  Schedule           `bson:"schedule" json:"schedule"`
+	CreatedAt time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updatedAt"`
+}
@@ -0,0 +1,6 @@
+package schedule
+
+type ActivityScheduleRepository interface {
+	FindActivitySchedule(activity string) (*ActivitySchedule, error)
+	UpsertActivitySchedule(activity string, schedule *Schedule) error
+}
@@ -0,0 +1,47 @@
+package schedule
+
+import "time"
+
+const (
+	TypeDaily       = "DAILY"
+	TypeTimesPerDay = "TIMES_PER_DAY"
+	TypeWeekly      = "WEEKLY"
+	TypeOneOff      = "ONE_OFF"
+)
+
+type TimeWindow struct {
+	Start string `bson:"start" json:"start" binding:"required,len=5"`
+	End   string `bson:"end" json:"end" binding:"required,len=5"`
+}
+
+// Schedule describes when an activity may be submitted. Times are evaluated
+// in the participant's timezone.
+type Schedule struct {
+	Type        string       `bson:"type" json:"type" binding:"required,oneof=DAILY TIMES_PER_DAY WEEKLY ONE_OFF"`
+	TimesPerDay int          `bson:"times_per_day,omitempty" json:"timesPerDay,omitempty" binding:"omitempty,min=1,max=24"`
+	Windows     []TimeWindow `bson:"windows,omitempty" json:"windows,omitempty" binding:"omitempty,max=24,dive"`
+	Weekdays    []int        `bson:"weekdays,omitempty" json:"weekdays,omitempty" binding:"omitempty,max=7,dive,min=0,max=6"`
+	StartDate   *time.Time   `bson:"start_date,omitempty" json:"startDate,omitempty"`
+	EndDate     *time.Time   `bson:"end_date,omitempty" json:"endDate,omitempty"`
+}
+
+// Window is one concrete period in which a single submission is allowed.
+type Window struct {
+	SlotKey string    `json:"slotKey"`
+	StartAt time.Time `json:"startAt"`
+	EndAt   time.Time `json:"endAt"`
+}
+
+func DefaultSchedule() *Schedule {
+	return &Schedule{
+		Type: TypeDaily,
+	}
+}
+
+// Availability tells a participant whether they can submit now and, when
+// they cannot, when the next window opens.
+type Availability struct {
+	Available     bool    `json:"available"`
+	CurrentWindow *Window `json:"currentWindow"`
+	NextWindow    *Window `json:"nextWindow"`
+}
@@ -13,13 +13,17 @@ const (
 )
 
 type User struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	Name        string             `bson:"name" json:"name"`
-	PhoneNumber string             `bson:"phone_number" json:"phoneNumber"`
-	State       string             `bson:"state" json:"state"`
-	GroupCode   *string            `bson:"group_code" json:"group"`
-	CreatedAt   time.Time          `bson:"created_at" json:"createdAt"`
-	UpdatedAt   time.Time          `bson:"updated_at" json:"updatedAt"`
+	ID                primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name              string             `bson:"name" json:"name"`
+	PhoneNumber       string             `bson:"phone_number" json:"phoneNumber"`
+	PhoneIndex        string             `bson:"phone_number_index" json:"-"`
+	State             string             `bson:"state" json:"state"`
+	GroupCode         *string            `bson:"group_code" json:"group"`
+	Timezone          string             `bson:"timezone" json:"timezone"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	CounsellorConsent bool               `bson:"counsellor_consent" json:"counsellorConsent"`
+	CreatedAt         time.Time          `bson:"created_at" json:"createdAt"`
+	UpdatedAt         time.Time          `bson:"updated_at" json:"updatedAt"`
 }
 
 func NewUser(phoneNumber string) *User {
@@ -28,6 +32,7 @@ func NewUser(phoneNumber string) *User {
 		PhoneNumber: phoneNumber,
 		State:       UserStatePending,
 		GroupCode:   nil,
+		Timezone:    DefaultTimezone,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
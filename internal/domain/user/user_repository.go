@@ -1,9 +1,13 @@
 package user
 
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
 type UserRepository interface {
 	CreateUser(user *User) error
 	FindUserByPhoneNumber(phoneNumber string) (*User, error)
 	FindUserById(id string) (*User, error)
-	UpdateUserInfo(id, name, group string) error
+	UpdateUserInfo(id, name, group, timezone, language string) error
+	UpdateCounsellorConsent(id string, consent bool) error
+	FindUsersByIdsOrGroupCodes(ids []primitive.ObjectID, groupCodes []string) (*[]User, error)
 	RemoveUserById(id string) error
 }
@@ -0,0 +1,40 @@
+package user
+
+import "time"
+
+const DefaultTimezone = "Asia/Bangkok"
+
+// defaultLocation is used when the timezone database is unavailable, so day
+// boundaries never silently fall back to UTC.
+var defaultLocation = time.FixedZone("ICT", 7*60*60)
+
+func ValidateTimezone(timezone string) bool {
+	if timezone == "" {
+		return false
+	}
+
+	_, err := time.LoadLocation(timezone)
+	return err == nil
+}
+
+// LoadLocation resolves timezone, falling back to the default timezone when it
+// is empty or unknown.
+func LoadLocation(timezone string) *time.Location {
+	if timezone != "" {
+		location, err := time.LoadLocation(timezone)
+		if err == nil {
+			return location
+		}
+	}
+
+	location, err := time.LoadLocation(DefaultTimezone)
+	if err != nil {
+		return defaultLocation
+	}
+
+	return location
+}
+
+func (u *User) Location() *time.Location {
+	return LoadLocation(u.Timezone)
+}
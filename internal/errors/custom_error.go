@@ -3,10 +3,11 @@ package errors
 import "fmt"
 
 type CustomError struct {
-	StatusCode    int    `json:"-"`
-	Code          string `json:"code"`
-	Message       string `json:"message"`
-	SystemMessage string `json:"-"`
+	StatusCode    int         `json:"-"`
+	Code          string      `json:"code"`
+	Message       string      `json:"message"`
+	SystemMessage string      `json:"-"`
+	Details       interface{} `json:"details,omitempty"`
 }
 
 func (e CustomError) Error() string {
@@ -21,3 +22,10 @@ func NewCustomError(statusCode int, code string, message, systemMessage string)
 		StatusCode:    statusCode,
 	}
 }
+
+// WithDetails attaches structured information, such as a list of rejected
+// items, that is returned to the client next to the code and message.
+func (e *CustomError) WithDetails(details interface{}) *CustomError {
+	e.Details = details
+	return e
+}
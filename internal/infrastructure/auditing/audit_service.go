@@ -0,0 +1,65 @@
+package auditing
+
+import (
+	"encoding/json"
+	"log"
+	"mucb_be/internal/domain/audit"
+)
+
+// SnapshotLoader fetches the current state of an entity so it can be stored
+// next to the change that was made to it.
+type SnapshotLoader func(id string) (interface{}, error)
+
+type AuditServiceInterface interface {
+	Snapshot(entity, id string) map[string]interface{}
+	Record(auditLog *audit.AuditLog)
+}
+
+type AuditService struct {
+	auditLogRepo audit.AuditLogRepository
+	loaders      map[string]SnapshotLoader
+}
+
+func NewAuditService(auditLogRepo audit.AuditLogRepository, loaders map[string]SnapshotLoader) AuditServiceInterface {
+	return &AuditService{
+		auditLogRepo: auditLogRepo,
+		loaders:      loaders,
+	}
+}
+
+// Snapshot returns the entity as it is serialised to API clients, so fields
+// hidden from JSON (such as password hashes) never reach the audit log.
+func (s *AuditService) Snapshot(entity, id string) map[string]interface{} {
+	loader, ok := s.loaders[entity]
+	if !ok || id == "" {
+		return nil
+	}
+
+	value, err := loader(id)
+	if err != nil {
+		return nil
+	}
+
+	return ToSnapshot(value)
+}
+
+func (s *AuditService) Record(auditLog *audit.AuditLog) {
+	err := s.auditLogRepo.CreateAuditLog(auditLog)
+	if err != nil {
+		log.Printf("Failed to write audit log for %s %s %s: %v", auditLog.Action, auditLog.Entity, auditLog.Target, err)
+	}
+}
+
+func ToSnapshot(value interface{}) map[string]interface{} {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var snapshot map[string]interface{}
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil
+	}
+
+	return snapshot
+}
@@ -0,0 +1,111 @@
+package authorization
+
+import (
+	"log"
+	"mucb_be/internal/domain/permission"
+	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/security"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// cacheTTL bounds how long a permission change made on another instance takes
+// to become visible here.
+const cacheTTL = 30 * time.Second
+
+type AuthorizationServiceInterface interface {
+	HasPermission(role, name string) bool
+	// Authorize is the single check usecases use before acting on behalf of
+	// the caller.
+	Authorize(claims *security.AccessTokenModel, name string) error
+	PermissionsOf(role string) []string
+	Invalidate()
+}
+
+type AuthorizationService struct {
+	rolePermissionRepo permission.RolePermissionRepository
+
+	mu       sync.RWMutex
+	cache    map[string]map[string]bool
+	loadedAt time.Time
+}
+
+func NewAuthorizationService(rolePermissionRepo permission.RolePermissionRepository) AuthorizationServiceInterface {
+	return &AuthorizationService{
+		rolePermissionRepo: rolePermissionRepo,
+	}
+}
+
+func (s *AuthorizationService) HasPermission(role, name string) bool {
+	return s.rolePermissions()[role][name]
+}
+
+func (s *AuthorizationService) Authorize(claims *security.AccessTokenModel, name string) error {
+	if claims == nil || !s.HasPermission(claims.Role, name) {
+		return errors.NewCustomError(
+			http.StatusForbidden,
+			"UCE000001001",
+			"Insufficient permissions.",
+			name,
+		)
+	}
+
+	return nil
+}
+
+func (s *AuthorizationService) PermissionsOf(role string) []string {
+	granted := s.rolePermissions()[role]
+
+	permissions := make([]string, 0, len(granted))
+	for _, name := range permission.All {
+		if granted[name] {
+			permissions = append(permissions, name)
+		}
+	}
+
+	return permissions
+}
+
+func (s *AuthorizationService) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache = nil
+}
+
+func (s *AuthorizationService) rolePermissions() map[string]map[string]bool {
+	s.mu.RLock()
+	if s.cache != nil && time.Since(s.loadedAt) < cacheTTL {
+		defer s.mu.RUnlock()
+		return s.cache
+	}
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rolePermissions, err := s.rolePermissionRepo.FindAllRolePermissions()
+	if err != nil {
+		// ✅ Keep serving the last known mapping rather than locking everyone out
+		log.Printf("Failed to load role permissions: %v", err)
+		if s.cache == nil {
+			return map[string]map[string]bool{}
+		}
+		return s.cache
+	}
+
+	cache := make(map[string]map[string]bool, len(*rolePermissions))
+	for _, rolePermission := range *rolePermissions {
+		granted := make(map[string]bool, len(rolePermission.Permissions))
+		for _, name := range rolePermission.Permissions {
+			granted[name] = true
+		}
+		cache[rolePermission.Role] = granted
+	}
+
+	s.cache = cache
+	s.loadedAt = time.Now()
+
+	return s.cache
+}
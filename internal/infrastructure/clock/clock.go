@@ -0,0 +1,33 @@
+package clock
+
+import "time"
+
+// ClockInterface lets usecases ask for the current time without calling
+// time.Now directly, so day boundaries can be tested with a fixed clock.
+type ClockInterface interface {
+	Now() time.Time
+}
+
+type SystemClock struct{}
+
+func NewSystemClock() ClockInterface {
+	return &SystemClock{}
+}
+
+func (c *SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+type FixedClock struct {
+	now time.Time
+}
+
+func NewFixedClock(now time.Time) ClockInterface {
+	return &FixedClock{
+		now: now,
+	}
+}
+
+func (c *FixedClock) Now() time.Time {
+	return c.now
+}
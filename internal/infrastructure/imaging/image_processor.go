@@ -0,0 +1,220 @@
+package imaging
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"mucb_be/internal/config"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultVariants     = "thumbnail=160,medium=640"
+	defaultMaxDimension = 8000
+	defaultMaxPixels    = 40000000
+	jpegQuality         = 85
+)
+
+// Variant is a named maximum width an upload is scaled down to.
+type Variant struct {
+	Name  string
+	Width int
+}
+
+type Resized struct {
+	Data        []byte
+	Width       int
+	Height      int
+	ContentType string
+	Hash        string
+}
+
+type ImageProcessorInterface interface {
+	Variants() []Variant
+	Decode(source io.Reader) (image.Image, string, error)
+	Sanitize(data []byte, extension string) (*Sanitized, error)
+	Resize(source image.Image, format string, width int) (*Resized, error)
+}
+
+type ImageProcessor struct {
+	variants     []Variant
+	maxDimension int
+	maxPixels    int
+}
+
+// NewImageProcessor reads the variants from IMAGE_VARIANTS, a comma separated
+// list of name=width pairs such as "thumbnail=160,medium=640", and the upload
+// limits from IMAGE_MAX_DIMENSION and IMAGE_MAX_PIXELS.
+func NewImageProcessor(cfg *config.Config) (ImageProcessorInterface, error) {
+	maxDimension, err := positiveOrDefault(cfg.ImageMaxDimension, defaultMaxDimension)
+	if err != nil {
+		return nil, fmt.Errorf("IMAGE_MAX_DIMENSION %w", err)
+	}
+
+	maxPixels, err := positiveOrDefault(cfg.ImageMaxPixels, defaultMaxPixels)
+	if err != nil {
+		return nil, fmt.Errorf("IMAGE_MAX_PIXELS %w", err)
+	}
+
+	spec := cfg.ImageVariants
+	if spec == "" {
+		spec = defaultVariants
+	}
+
+	variants := make([]Variant, 0)
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(spec, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return nil, fmt.Errorf("image variant %q must be name=width", entry)
+		}
+
+		width, err := strconv.Atoi(value)
+		if err != nil || width < 1 {
+			return nil, fmt.Errorf("image variant %q needs a positive width", entry)
+		}
+
+		if name == "" || name == "original" || seen[name] {
+			return nil, fmt.Errorf("image variant name %q is reserved or repeated", name)
+		}
+		seen[name] = true
+
+		variants = append(variants, Variant{Name: name, Width: width})
+	}
+
+	sort.Slice(variants, func(i, j int) bool {
+		return variants[i].Width < variants[j].Width
+	})
+
+	return &ImageProcessor{
+		variants:     variants,
+		maxDimension: maxDimension,
+		maxPixels:    maxPixels,
+	}, nil
+}
+
+func positiveOrDefault(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return 0, fmt.Errorf("must be a positive number")
+	}
+
+	return number, nil
+}
+
+// Variants returns the configured variants from the narrowest to the widest.
+func (p *ImageProcessor) Variants() []Variant {
+	return p.variants
+}
+
+func (p *ImageProcessor) Decode(source io.Reader) (image.Image, string, error) {
+	return image.Decode(source)
+}
+
+// Resize scales the image down to width, keeping its aspect ratio, and
+// encodes it in its original format. Images are never scaled up.
+func (p *ImageProcessor) Resize(source image.Image, format string, width int) (*Resized, error) {
+	bounds := source.Bounds()
+	if width > bounds.Dx() {
+		width = bounds.Dx()
+	}
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	resized := boxResize(source, width, height)
+
+	var buffer bytes.Buffer
+	var contentType string
+	switch format {
+	case "jpeg":
+		contentType = "image/jpeg"
+		if err := jpeg.Encode(&buffer, resized, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+	case "png":
+		contentType = "image/png"
+		if err := png.Encode(&buffer, resized); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("can not encode %s images", format)
+	}
+
+	sum := sha256.Sum256(buffer.Bytes())
+
+	return &Resized{
+		Data:        buffer.Bytes(),
+		Width:       width,
+		Height:      height,
+		ContentType: contentType,
+		Hash:        hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+// boxResize averages every source pixel that falls into a target pixel,
+// which gives clean results for the downscaling done here.
+func boxResize(source image.Image, width, height int) *image.RGBA {
+	bounds := source.Bounds()
+	src := toRGBA(source)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+
+	for y := 0; y < height; y++ {
+		y0 := y * srcHeight / height
+		y1 := (y + 1) * srcHeight / height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+
+		for x := 0; x < width; x++ {
+			x0 := x * srcWidth / width
+			x1 := (x + 1) * srcWidth / width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, count int
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += int(src.Pix[offset])
+					g += int(src.Pix[offset+1])
+					b += int(src.Pix[offset+2])
+					a += int(src.Pix[offset+3])
+					offset += 4
+					count++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / count)
+			dst.Pix[offset+1] = uint8(g / count)
+			dst.Pix[offset+2] = uint8(b / count)
+			dst.Pix[offset+3] = uint8(a / count)
+		}
+	}
+
+	return dst
+}
+
+func toRGBA(source image.Image) *image.RGBA {
+	bounds := source.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), source, bounds.Min, draw.Src)
+	return rgba
+}
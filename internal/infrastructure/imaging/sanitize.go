@@ -0,0 +1,125 @@
+package imaging
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"strings"
+)
+
+const sanitizedJpegQuality = 92
+
+var (
+	ErrUnsupportedType = errors.New("unsupported image type")
+	ErrTypeMismatch    = errors.New("file extension does not match its content")
+	ErrPolyglot        = errors.New("image carries data that is not part of the image")
+	ErrTooLarge        = errors.New("image dimensions exceed the limit")
+)
+
+var formatsByContentType = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+}
+
+var formatsByExtension = map[string]string{
+	".jpg":  "jpeg",
+	".jpeg": "jpeg",
+	".png":  "png",
+}
+
+var pngEnd = []byte{0x00, 0x00, 0x00, 0x00, 'I', 'E', 'N', 'D', 0xAE, 0x42, 0x60, 0x82}
+
+type Sanitized struct {
+	Data        []byte
+	Image       image.Image
+	Format      string
+	ContentType string
+	Width       int
+	Height      int
+	Hash        string
+}
+
+// Sanitize checks an upload by its content instead of its name, then
+// decodes and encodes it again so only pixels are kept. EXIF metadata such as
+// GPS positions is dropped, and the EXIF orientation is applied to the pixels
+// first so photos keep facing up. Hash is the SHA-256 of the stored bytes.
+func (p *ImageProcessor) Sanitize(data []byte, extension string) (*Sanitized, error) {
+	contentType := http.DetectContentType(data)
+	format, ok := formatsByContentType[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, contentType)
+	}
+
+	if formatsByExtension[strings.ToLower(extension)] != format {
+		return nil, fmt.Errorf("%w: %s is %s", ErrTypeMismatch, extension, contentType)
+	}
+
+	if err := checkPolyglot(data, format); err != nil {
+		return nil, err
+	}
+
+	// ✅ Read the header first so a decompression bomb is never decoded
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if config.Width > p.maxDimension || config.Height > p.maxDimension || config.Width*config.Height > p.maxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", ErrTooLarge, config.Width, config.Height)
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	switch format {
+	case "jpeg":
+		decoded = orient(decoded, jpegOrientation(data))
+		err = jpeg.Encode(&buffer, decoded, &jpeg.Options{Quality: sanitizedJpegQuality})
+	case "png":
+		err = png.Encode(&buffer, decoded)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(buffer.Bytes())
+	bounds := decoded.Bounds()
+
+	return &Sanitized{
+		Data:        buffer.Bytes(),
+		Image:       decoded,
+		Format:      format,
+		ContentType: contentType,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+		Hash:        hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+// checkPolyglot rejects files with bytes after the end of the image. Markup
+// hidden in metadata or comments inside the image is dropped by the
+// re-encode, so the image data itself is not scanned; compressed pixels can
+// contain any byte sequence by chance.
+func checkPolyglot(data []byte, format string) error {
+	switch format {
+	case "jpeg":
+		end := bytes.TrimRight(data, "\x00")
+		if !bytes.HasSuffix(end, []byte{0xFF, 0xD9}) {
+			return fmt.Errorf("%w: data after the end of the jpeg", ErrPolyglot)
+		}
+	case "png":
+		if !bytes.HasSuffix(data, pngEnd) {
+			return fmt.Errorf("%w: data after the end of the png", ErrPolyglot)
+		}
+	}
+
+	return nil
+}
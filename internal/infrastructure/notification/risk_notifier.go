@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"mucb_be/internal/config"
+	"net/http"
+	"time"
+)
+
+// RiskAlert tells counsellors that a journal entry needs review. It carries
+// identifiers and a severity only; the entry and what matched in it stay in
+// the database.
+type RiskAlert struct {
+	StoryRecord string    `json:"storyRecord"`
+	User        string    `json:"user"`
+	Severity    string    `json:"severity"`
+	Recipients  []string  `json:"recipients"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type RiskNotifierInterface interface {
+	NotifyRiskAlert(alert *RiskAlert) error
+}
+
+// NewRiskNotifier posts alerts to RISK_ALERT_WEBHOOK_URL when it is set and
+// falls back to the application log otherwise.
+func NewRiskNotifier(cfg *config.Config) RiskNotifierInterface {
+	if cfg.RiskAlertWebhookUrl != "" {
+		return NewWebhookRiskNotifier(cfg.RiskAlertWebhookUrl)
+	}
+
+	return NewLogRiskNotifier()
+}
+
+type LogRiskNotifier struct{}
+
+func NewLogRiskNotifier() RiskNotifierInterface {
+	return &LogRiskNotifier{}
+}
+
+func (n *LogRiskNotifier) NotifyRiskAlert(alert *RiskAlert) error {
+	log.Printf("Risk alert: story %s from user %s with %s severity, recipients %v", alert.StoryRecord, alert.User, alert.Severity, alert.Recipients)
+	return nil
+}
+
+type WebhookRiskNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookRiskNotifier(url string) RiskNotifierInterface {
+	return &WebhookRiskNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (n *WebhookRiskNotifier) NotifyRiskAlert(alert *RiskAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("risk alert webhook returned %d", resp.StatusCode)
+	}
+
+	return nil
+}
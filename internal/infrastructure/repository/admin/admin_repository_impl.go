@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"mucb_be/internal/domain/admin"
 	"time"
 
@@ -56,3 +57,50 @@ func (r *AdminRepositoryMongo) FindAdminById(id string) (*admin.Admin, error) {
 	}
 	return &result, nil
 }
+
+func (r *AdminRepositoryMongo) FindRiskAlertAdmins() (*[]admin.Admin, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cursor, err := r.adminCollection.Find(ctx, bson.M{"receive_risk_alert": true})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	admins := make([]admin.Admin, 0)
+	err = cursor.All(ctx, &admins)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admins, nil
+}
+
+func (r *AdminRepositoryMongo) UpdateRiskAlertById(id string, receiveRiskAlert bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"receive_risk_alert": receiveRiskAlert,
+			"updated_at":         time.Now(),
+		},
+	}
+
+	result, err := r.adminCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
+}
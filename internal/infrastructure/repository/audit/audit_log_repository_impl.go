@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"mucb_be/internal/domain/audit"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AuditLogRepositoryMongo struct {
+	auditLogCollection *mongo.Collection
+}
+
+func NewAuditLogRepositoryMongo(auditLogCollection *mongo.Collection) audit.AuditLogRepository {
+	return &AuditLogRepositoryMongo{
+		auditLogCollection: auditLogCollection,
+	}
+}
+
+func (r *AuditLogRepositoryMongo) CreateAuditLog(auditLog *audit.AuditLog) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.auditLogCollection.InsertOne(ctx, auditLog)
+	return err
+}
+
+func (r *AuditLogRepositoryMongo) FindAuditLogs(filter audit.AuditLogFilter, page, limit int) (*[]audit.AuditLog, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	offset := (page - 1) * limit
+
+	query := bson.M{}
+	if filter.Actor != nil {
+		query["actor"] = *filter.Actor
+	}
+	if filter.Entity != nil {
+		query["entity"] = *filter.Entity
+	}
+	if filter.Target != nil {
+		query["target"] = *filter.Target
+	}
+	if filter.From != nil || filter.To != nil {
+		createdAt := bson.M{}
+		if filter.From != nil {
+			createdAt["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			createdAt["$lt"] = *filter.To
+		}
+		query["created_at"] = createdAt
+	}
+
+	total, err := r.auditLogCollection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit)).
+		SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.auditLogCollection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	auditLogs := make([]audit.AuditLog, 0)
+	if err := cursor.All(ctx, &auditLogs); err != nil {
+		return nil, 0, err
+	}
+
+	return &auditLogs, int(total), nil
+}
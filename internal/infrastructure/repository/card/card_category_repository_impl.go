@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"mucb_be/internal/domain/card"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CardCategoryRepositoryMongo struct {
+	cardCategoryCollection *mongo.Collection
+}
+
+func NewCardCategoryRepositoryMongo(cardCategoryCollection *mongo.Collection) card.CardCategoryRepository {
+	return &CardCategoryRepositoryMongo{
+		cardCategoryCollection: cardCategoryCollection,
+	}
+}
+
+func (r *CardCategoryRepositoryMongo) CreateCardCategory(cardCategory *card.CardCategory) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.cardCategoryCollection.InsertOne(ctx, cardCategory)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return card.ErrDuplicateCategoryCode
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *CardCategoryRepositoryMongo) FindAllCardCategories() (*[]card.CardCategory, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.M{"code": 1})
+
+	cursor, err := r.cardCategoryCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	cardCategories := make([]card.CardCategory, 0)
+	if err := cursor.All(ctx, &cardCategories); err != nil {
+		return nil, err
+	}
+
+	return &cardCategories, nil
+}
+
+func (r *CardCategoryRepositoryMongo) FindCardCategoryById(id string) (*card.CardCategory, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var cardCategory card.CardCategory
+	err = r.cardCategoryCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&cardCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cardCategory, nil
+}
+
+func (r *CardCategoryRepositoryMongo) FindCardCategoryByCode(code string) (*card.CardCategory, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var cardCategory card.CardCategory
+	err := r.cardCategoryCollection.FindOne(ctx, bson.M{"code": code}).Decode(&cardCategory)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("data not found")
+		}
+		return nil, err
+	}
+
+	return &cardCategory, nil
+}
+
+func (r *CardCategoryRepositoryMongo) UpdateCardCategoryById(id, name string, limit int, translations map[string]card.CardCategoryText) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":         name,
+			"limit":        limit,
+			"translations": translations,
+			"updated_at":   time.Now(),
+		},
+	}
+
+	result, err := r.cardCategoryCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
+}
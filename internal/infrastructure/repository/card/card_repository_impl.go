@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"mucb_be/internal/database"
 	"mucb_be/internal/domain/card"
 	"time"
 
@@ -13,20 +14,37 @@ import (
 )
 
 type CardRepositoryMongo struct {
-	cardCollection *mongo.Collection
+	cardCollection       *mongo.Collection
+	cardRecordCollection *mongo.Collection
 }
 
-func NewCardRepositoryMongo(cardCollection *mongo.Collection) card.CardRepository {
+func NewCardRepositoryMongo(cardCollection, cardRecordCollection *mongo.Collection) card.CardRepository {
 	return &CardRepositoryMongo{
-		cardCollection: cardCollection,
+		cardCollection:       cardCollection,
+		cardRecordCollection: cardRecordCollection,
 	}
 }
 
+// CreateCard places the new card after every existing card, so it never
+// ties with a card that has already been ordered.
 func (r *CardRepositoryMongo) CreateCard(card *card.Card) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := r.cardCollection.InsertOne(ctx, card)
+	var last struct {
+		Position int `bson:"position"`
+	}
+	err := r.cardCollection.FindOne(ctx, bson.M{}, options.FindOne().
+		SetSort(bson.D{{Key: "position", Value: -1}}).
+		SetProjection(bson.M{"position": 1})).Decode(&last)
+	switch {
+	case err == nil:
+		card.Position = last.Position + 1
+	case !errors.Is(err, mongo.ErrNoDocuments):
+		return err
+	}
+
+	_, err = r.cardCollection.InsertOne(ctx, card)
 	return err
 }
 
@@ -47,16 +65,24 @@ func (r *CardRepositoryMongo) FindCardById(id string) (*card.Card, error) {
 	return &result, nil
 }
 
-func (r *CardRepositoryMongo) FindAllCardByRole(page, limit int, isAdmin bool) (*[]card.Card, int, error) {
+func (r *CardRepositoryMongo) FindAllCardByRole(page, limit int, isAdmin bool, cardFilter card.CardFilter) (*[]card.Card, int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	offset := (page - 1) * limit
 
-	filter := bson.M{}
+	filter := bson.M{
+		"archived_at": bson.M{"$exists": cardFilter.Archived},
+	}
 	if !isAdmin {
 		filter["is_active"] = true
 	}
+	if cardFilter.Category != "" {
+		filter["category"] = cardFilter.Category
+	}
+	if cardFilter.Tag != "" {
+		filter["tags"] = cardFilter.Tag
+	}
 
 	total, err := r.cardCollection.CountDocuments(ctx, filter)
 	if err != nil {
@@ -66,7 +92,7 @@ func (r *CardRepositoryMongo) FindAllCardByRole(page, limit int, isAdmin bool) (
 	opts := options.Find().
 		SetSkip(int64(offset)).
 		SetLimit(int64(limit)).
-		SetSort(bson.M{"created_at": -1})
+		SetSort(bson.D{{Key: "position", Value: 1}, {Key: "created_at", Value: -1}})
 
 	cursor, err := r.cardCollection.Find(ctx, filter, opts)
 	if err != nil {
@@ -83,6 +109,47 @@ func (r *CardRepositoryMongo) FindAllCardByRole(page, limit int, isAdmin bool) (
 
 }
 
+func (r *CardRepositoryMongo) FindCards() (*[]card.Card, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.cardCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	cards := make([]card.Card, 0)
+	if err := cursor.All(ctx, &cards); err != nil {
+		return nil, err
+	}
+
+	return &cards, nil
+}
+
+func (r *CardRepositoryMongo) FindCardsByIds(ids []primitive.ObjectID) (*[]card.Card, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cursor, err := r.cardCollection.Find(ctx, bson.M{
+		"_id": bson.M{"$in": ids},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	cards := make([]card.Card, 0)
+	if err := cursor.All(ctx, &cards); err != nil {
+		return nil, err
+	}
+
+	return &cards, nil
+}
+
 func (r *CardRepositoryMongo) FindCardByIdAndActivate(id string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -99,6 +166,38 @@ func (r *CardRepositoryMongo) FindCardByIdAndActivate(id string) error {
 		},
 	}
 
+	filter := bson.M{
+		"_id":         objectID,
+		"archived_at": bson.M{"$exists": false},
+	}
+
+	result := r.cardCollection.FindOneAndUpdate(ctx, filter, update)
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return errors.New("card not found")
+		}
+		return result.Err()
+	}
+
+	return nil
+}
+
+func (r *CardRepositoryMongo) FindCardByIdAndDeactivate(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"is_active":  false,
+			"updated_at": time.Now(),
+		},
+	}
+
 	result := r.cardCollection.FindOneAndUpdate(ctx, bson.M{"_id": objectID}, update)
 	if result.Err() != nil {
 		if result.Err() == mongo.ErrNoDocuments {
@@ -110,7 +209,7 @@ func (r *CardRepositoryMongo) FindCardByIdAndActivate(id string) error {
 	return nil
 }
 
-func (r *CardRepositoryMongo) UpdateCardById(id string, name string, description string, image primitive.ObjectID) error {
+func (r *CardRepositoryMongo) UpdateCardById(id string, name string, description string, image primitive.ObjectID, category string, tags []string, translations map[string]card.CardText) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -121,10 +220,13 @@ func (r *CardRepositoryMongo) UpdateCardById(id string, name string, description
 
 	update := bson.M{
 		"$set": bson.M{
-			"name":        name,
-			"description": description,
-			"image":       image,
-			"updated_at":  time.Now(),
+			"name":         name,
+			"description":  description,
+			"image":        image,
+			"category":     category,
+			"tags":         tags,
+			"translations": translations,
+			"updated_at":   time.Now(),
 		},
 	}
 
@@ -138,3 +240,110 @@ func (r *CardRepositoryMongo) UpdateCardById(id string, name string, description
 
 	return nil
 }
+
+// ReorderCards gives the cards their position in the given order. Cards left
+// out keep their position. Nothing is written unless every card exists.
+func (r *CardRepositoryMongo) ReorderCards(ids []primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	count, err := r.cardCollection.CountDocuments(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return err
+	}
+
+	if int(count) != len(ids) {
+		return errors.New("card not found")
+	}
+
+	models := make([]mongo.WriteModel, 0, len(ids))
+	for position, id := range ids {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": id}).
+			SetUpdate(bson.M{"$set": bson.M{
+				"position":   position,
+				"updated_at": time.Now(),
+			}}))
+	}
+
+	result, err := r.cardCollection.BulkWrite(ctx, models)
+	if err != nil {
+		return err
+	}
+
+	if int(result.MatchedCount) != len(ids) {
+		return errors.New("card not found")
+	}
+
+	return nil
+}
+
+// ArchiveCardById sets or, with a nil time, clears archived_at. An archived
+// card is deactivated and stays inactive when it is restored.
+func (r *CardRepositoryMongo) ArchiveCardById(id string, archivedAt *time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"archived_at": archivedAt,
+			"is_active":   false,
+			"updated_at":  time.Now(),
+		},
+	}
+	if archivedAt == nil {
+		update = bson.M{
+			"$set":   bson.M{"updated_at": time.Now()},
+			"$unset": bson.M{"archived_at": ""},
+		}
+	}
+
+	result, err := r.cardCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New("card not found")
+	}
+
+	return nil
+}
+
+// RemoveCardById deletes a card that has never been picked. The records are
+// checked in the same transaction as the delete.
+func (r *CardRepositoryMongo) RemoveCardById(id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	return database.WithTransaction(r.cardCollection.Database(), func(sessionCtx mongo.SessionContext) error {
+		count, err := r.cardRecordCollection.CountDocuments(sessionCtx, bson.M{
+			"card": objectID,
+		}, options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return card.ErrCardHasRecords
+		}
+
+		result, err := r.cardCollection.DeleteOne(sessionCtx, bson.M{"_id": objectID})
+		if err != nil {
+			return err
+		}
+
+		if result.DeletedCount == 0 {
+			return errors.New("card not found")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"mucb_be/internal/domain/counselling"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AccessLogRepositoryMongo struct {
+	accessLogCollection *mongo.Collection
+}
+
+func NewAccessLogRepositoryMongo(accessLogCollection *mongo.Collection) counselling.AccessLogRepository {
+	return &AccessLogRepositoryMongo{
+		accessLogCollection: accessLogCollection,
+	}
+}
+
+func (r *AccessLogRepositoryMongo) CreateAccessLog(accessLog *counselling.AccessLog) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.accessLogCollection.InsertOne(ctx, accessLog)
+	return err
+}
+
+func (r *AccessLogRepositoryMongo) FindAccessLogs(counsellor *primitive.ObjectID, page, limit int) (*[]counselling.AccessLog, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	offset := (page - 1) * limit
+
+	filter := bson.M{}
+	if counsellor != nil {
+		filter["counsellor"] = *counsellor
+	}
+
+	total, err := r.accessLogCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit)).
+		SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.accessLogCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	accessLogs := make([]counselling.AccessLog, 0)
+	if err := cursor.All(ctx, &accessLogs); err != nil {
+		return nil, 0, err
+	}
+
+	return &accessLogs, int(total), nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"mucb_be/internal/domain/counselling"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type AssignmentRepositoryMongo struct {
+	assignmentCollection *mongo.Collection
+}
+
+func NewAssignmentRepositoryMongo(assignmentCollection *mongo.Collection) counselling.AssignmentRepository {
+	return &AssignmentRepositoryMongo{
+		assignmentCollection: assignmentCollection,
+	}
+}
+
+func (r *AssignmentRepositoryMongo) CreateAssignment(assignment *counselling.Assignment) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.assignmentCollection.InsertOne(ctx, assignment)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return counselling.ErrDuplicateAssignment
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *AssignmentRepositoryMongo) FindAssignmentsByCounsellor(counsellor primitive.ObjectID) (*[]counselling.Assignment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.assignmentCollection.Find(ctx, bson.M{"counsellor": counsellor}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	assignments := make([]counselling.Assignment, 0)
+	err = cursor.All(ctx, &assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &assignments, nil
+}
+
+func (r *AssignmentRepositoryMongo) IsAssigned(counsellor, user primitive.ObjectID, groupCode *string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conditions := bson.A{
+		bson.M{"user": user},
+	}
+	if groupCode != nil && *groupCode != "" {
+		conditions = append(conditions, bson.M{"group_code": *groupCode})
+	}
+
+	filter := bson.M{
+		"counsellor": counsellor,
+		"$or":        conditions,
+	}
+
+	count, err := r.assignmentCollection.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *AssignmentRepositoryMongo) RemoveAssignmentById(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.assignmentCollection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"mucb_be/internal/domain/counselling"
+	"mucb_be/internal/infrastructure/security"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CounsellorNoteRepositoryMongo struct {
+	counsellorNoteCollection *mongo.Collection
+	fieldEncryptionService   security.FieldEncryptionServiceInterface
+}
+
+func NewCounsellorNoteRepositoryMongo(counsellorNoteCollection *mongo.Collection, fieldEncryptionService security.FieldEncryptionServiceInterface) counselling.CounsellorNoteRepository {
+	return &CounsellorNoteRepositoryMongo{
+		counsellorNoteCollection: counsellorNoteCollection,
+		fieldEncryptionService:   fieldEncryptionService,
+	}
+}
+
+func (r *CounsellorNoteRepositoryMongo) CreateCounsellorNote(counsellorNote *counselling.CounsellorNote) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	encryptedContent, err := r.fieldEncryptionService.Encrypt(counsellorNote.Content)
+	if err != nil {
+		return err
+	}
+
+	document := *counsellorNote
+	document.Content = encryptedContent
+
+	_, err = r.counsellorNoteCollection.InsertOne(ctx, document)
+	return err
+}
+
+func (r *CounsellorNoteRepositoryMongo) FindCounsellorNotes(counsellor, user primitive.ObjectID) (*[]counselling.CounsellorNote, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	filter := bson.M{
+		"counsellor": counsellor,
+		"user":       user,
+	}
+
+	cursor, err := r.counsellorNoteCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	counsellorNotes := make([]counselling.CounsellorNote, 0)
+	err = cursor.All(ctx, &counsellorNotes)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range counsellorNotes {
+		content, err := r.fieldEncryptionService.Decrypt(counsellorNotes[index].Content)
+		if err != nil {
+			return nil, err
+		}
+		counsellorNotes[index].Content = content
+	}
+
+	return &counsellorNotes, nil
+}
+
+func (r *CounsellorNoteRepositoryMongo) RemoveDataByUserId(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.counsellorNoteCollection.DeleteMany(ctx, bson.M{"user": objectID})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
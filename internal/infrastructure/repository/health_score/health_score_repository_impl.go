@@ -77,7 +77,7 @@ func (r *HealthScoreRepositoryMongo) FindHealthScoreById(id string) (*health_sco
 	return &healthScore, nil
 }
 
-func (r *HealthScoreRepositoryMongo) UpdateHealthScoreById(id string, contents []health_score.HealthScoreContent, maximumPercent int) error {
+func (r *HealthScoreRepositoryMongo) UpdateHealthScoreById(id string, contents []health_score.HealthScoreContent, translations map[string][]health_score.HealthScoreContent, maximumPercent int) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -89,6 +89,7 @@ func (r *HealthScoreRepositoryMongo) UpdateHealthScoreById(id string, contents [
 	update := bson.M{
 		"$set": bson.M{
 			"contents":        contents,
+			"translations":    translations,
 			"maximum_percent": maximumPercent,
 			"updated_at":      time.Now(),
 		},
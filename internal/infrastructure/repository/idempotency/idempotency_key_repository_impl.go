@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"mucb_be/internal/domain/idempotency"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type IdempotencyKeyRepositoryMongo struct {
+	idempotencyKeyCollection *mongo.Collection
+}
+
+func NewIdempotencyKeyRepositoryMongo(idempotencyKeyCollection *mongo.Collection) idempotency.IdempotencyKeyRepository {
+	return &IdempotencyKeyRepositoryMongo{
+		idempotencyKeyCollection: idempotencyKeyCollection,
+	}
+}
+
+func (r *IdempotencyKeyRepositoryMongo) CreateIdempotencyKey(idempotencyKey *idempotency.IdempotencyKey) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.idempotencyKeyCollection.InsertOne(ctx, idempotencyKey)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return idempotency.ErrIdempotencyKeyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *IdempotencyKeyRepositoryMongo) FindIdempotencyKey(user primitive.ObjectID, key string) (*idempotency.IdempotencyKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var result idempotency.IdempotencyKey
+	err := r.idempotencyKeyCollection.FindOne(ctx, bson.M{
+		"user": user,
+		"key":  key,
+	}).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (r *IdempotencyKeyRepositoryMongo) CompleteIdempotencyKeyById(id string, responseStatus int, responseBody, contentType string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"status":          idempotency.StatusCompleted,
+			"response_status": responseStatus,
+			"response_body":   responseBody,
+			"content_type":    contentType,
+			"updated_at":      time.Now(),
+		},
+	}
+
+	result, err := r.idempotencyKeyCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
+}
+
+func (r *IdempotencyKeyRepositoryMongo) RemoveIdempotencyKeyById(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.idempotencyKeyCollection.DeleteOne(ctx, bson.M{"_id": objectID})
+	return err
+}
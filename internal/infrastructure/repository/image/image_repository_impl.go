@@ -3,29 +3,40 @@ package repository
 import (
 	"context"
 	"fmt"
+	"mucb_be/internal/database"
+	"mucb_be/internal/domain/health_score"
 	"mucb_be/internal/domain/image"
+	"mucb_be/internal/domain/locale"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type ImageRepositoryMongo struct {
-	imageCollection *mongo.Collection
+	imageCollection       *mongo.Collection
+	cardCollection        *mongo.Collection
+	healthScoreCollection *mongo.Collection
 }
 
-func NewImageRepositoryMongo(imageCollection *mongo.Collection) image.ImageRepository {
+func NewImageRepositoryMongo(imageCollection, cardCollection, healthScoreCollection *mongo.Collection) image.ImageRepository {
 	return &ImageRepositoryMongo{
-		imageCollection: imageCollection,
+		imageCollection:       imageCollection,
+		cardCollection:        cardCollection,
+		healthScoreCollection: healthScoreCollection,
 	}
 }
 
-func (r *ImageRepositoryMongo) CreateImage(image *image.Image) error {
+func (r *ImageRepositoryMongo) CreateImage(newImage *image.Image) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := r.imageCollection.InsertOne(ctx, image)
+	_, err := r.imageCollection.InsertOne(ctx, newImage)
+	if mongo.IsDuplicateKeyError(err) {
+		return image.ErrDuplicateHash
+	}
 	return err
 }
 
@@ -46,6 +57,26 @@ func (r *ImageRepositoryMongo) FindImageByID(id string) (*image.Image, error) {
 	return &result, nil
 }
 
+// FindImageByHash returns the newest image that is not archived with the
+// given content hash.
+func (r *ImageRepositoryMongo) FindImageByHash(hash string) (*image.Image, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"hash":        hash,
+		"archived_at": bson.M{"$exists": false},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	var result image.Image
+	err := r.imageCollection.FindOne(ctx, filter, opts).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 func (r *ImageRepositoryMongo) UpdateImageStatusById(id string, currentStats bool) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -77,3 +108,123 @@ func (r *ImageRepositoryMongo) UpdateImageStatusById(id string, currentStats boo
 
 	return nil
 }
+
+// ArchiveImageById sets or, with a nil time, clears archived_at. Archived
+// images are still served so cards and records that show them keep working.
+func (r *ImageRepositoryMongo) ArchiveImageById(id string, archivedAt *time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"archived_at": archivedAt,
+			"updated_at":  time.Now(),
+		},
+	}
+	if archivedAt == nil {
+		update = bson.M{
+			"$set":   bson.M{"updated_at": time.Now()},
+			"$unset": bson.M{"archived_at": ""},
+		}
+	}
+
+	result, err := r.imageCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return image.ErrDuplicateHash
+		}
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
+}
+
+// RemoveImageById deletes an image that no card, archived ones included,
+// and no health score shows. The usages are checked in the same transaction
+// as the delete.
+func (r *ImageRepositoryMongo) RemoveImageById(id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	return database.WithTransaction(r.imageCollection.Database(), func(sessionCtx mongo.SessionContext) error {
+		cards, err := r.cardCollection.CountDocuments(sessionCtx, bson.M{
+			"image": objectID,
+		}, options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+
+		healthScores, err := r.healthScoreCollection.CountDocuments(sessionCtx, healthScoreImageFilter(id), options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+
+		if cards > 0 || healthScores > 0 {
+			return image.ErrImageInUse
+		}
+
+		result, err := r.imageCollection.DeleteOne(sessionCtx, bson.M{"_id": objectID})
+		if err != nil {
+			return err
+		}
+
+		if result.DeletedCount == 0 {
+			return fmt.Errorf("data not found")
+		}
+
+		return nil
+	})
+}
+
+// healthScoreImageFilter matches health scores that show the image in their
+// base contents or in one of their translations.
+func healthScoreImageFilter(id string) bson.M {
+	content := bson.M{
+		"$elemMatch": bson.M{
+			"content_type": health_score.ContentTypeImage,
+			"content":      id,
+		},
+	}
+
+	conditions := bson.A{bson.M{"contents": content}}
+	for _, name := range locale.Supported {
+		conditions = append(conditions, bson.M{"translations." + name: content})
+	}
+
+	return bson.M{"$or": conditions}
+}
+
+// AddImageVariant records a variant unless one with the same name is already
+// there, so two requests generating it at once store it only once.
+func (r *ImageRepositoryMongo) AddImageVariant(id string, variant image.ImageVariant) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{
+		"_id":           objectID,
+		"variants.name": bson.M{"$ne": variant.Name},
+	}
+	update := bson.M{
+		"$push": bson.M{"variants": variant},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	_, err = r.imageCollection.UpdateOne(ctx, filter, update)
+	return err
+}
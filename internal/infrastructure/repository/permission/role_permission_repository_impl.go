@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"mucb_be/internal/domain/permission"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RolePermissionRepositoryMongo struct {
+	rolePermissionCollection *mongo.Collection
+}
+
+func NewRolePermissionRepositoryMongo(rolePermissionCollection *mongo.Collection) permission.RolePermissionRepository {
+	return &RolePermissionRepositoryMongo{
+		rolePermissionCollection: rolePermissionCollection,
+	}
+}
+
+func (r *RolePermissionRepositoryMongo) FindAllRolePermissions() (*[]permission.RolePermission, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "role", Value: 1}})
+
+	cursor, err := r.rolePermissionCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	rolePermissions := make([]permission.RolePermission, 0)
+	err = cursor.All(ctx, &rolePermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rolePermissions, nil
+}
+
+func (r *RolePermissionRepositoryMongo) UpdatePermissionsByRole(role string, permissions []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	newRolePermission := permission.NewRolePermission(role, permissions)
+	update := bson.M{
+		"$set": bson.M{
+			"permissions": permissions,
+			"updated_at":  time.Now(),
+		},
+		"$setOnInsert": bson.M{
+			"_id":               newRolePermission.ID,
+			"known_permissions": newRolePermission.Known,
+			"created_at":        newRolePermission.CreatedAt,
+		},
+	}
+
+	_, err := r.rolePermissionCollection.UpdateOne(ctx, bson.M{"role": role}, update, options.Update().SetUpsert(true))
+	return err
+}
@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"fmt"
+	"mucb_be/internal/database"
+	"mucb_be/internal/domain/question"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type QuestionBankRepositoryMongo struct {
+	questionGroupCollection    *mongo.Collection
+	questionChoiceCollection   *mongo.Collection
+	questionRevisionCollection *mongo.Collection
+	questionnaireCollection    *mongo.Collection
+	groupRecordCollection      *mongo.Collection
+}
+
+func NewQuestionBankRepositoryMongo(questionGroupCollection, questionChoiceCollection, questionRevisionCollection, questionnaireCollection, groupRecordCollection *mongo.Collection) question.QuestionBankRepository {
+	return &QuestionBankRepositoryMongo{
+		questionGroupCollection:    questionGroupCollection,
+		questionChoiceCollection:   questionChoiceCollection,
+		questionRevisionCollection: questionRevisionCollection,
+		questionnaireCollection:    questionnaireCollection,
+		groupRecordCollection:      groupRecordCollection,
+	}
+}
+
+// withTransaction runs fn in one transaction across the question
+// collections.
+func (r *QuestionBankRepositoryMongo) withTransaction(fn func(sessionCtx mongo.SessionContext) error) error {
+	return database.WithTransaction(r.questionGroupCollection.Database(), fn)
+}
+
+// ImportQuestionBank writes every group and choice by id in one transaction,
+// so an import is either applied as a whole or not at all.
+func (r *QuestionBankRepositoryMongo) ImportQuestionBank(questionGroups []question.QuestionGroup, questionChoices []question.QuestionChoice) error {
+	return r.withTransaction(func(sessionCtx mongo.SessionContext) error {
+		if len(questionGroups) > 0 {
+			models := make([]mongo.WriteModel, 0, len(questionGroups))
+			for _, questionGroup := range questionGroups {
+				models = append(models, mongo.NewReplaceOneModel().
+					SetFilter(bson.M{"_id": questionGroup.ID}).
+					SetReplacement(questionGroup).
+					SetUpsert(true))
+			}
+			if _, err := r.questionGroupCollection.BulkWrite(sessionCtx, models); err != nil {
+				return err
+			}
+		}
+
+		if len(questionChoices) > 0 {
+			models := make([]mongo.WriteModel, 0, len(questionChoices))
+			for _, questionChoice := range questionChoices {
+				models = append(models, mongo.NewReplaceOneModel().
+					SetFilter(bson.M{"_id": questionChoice.ID}).
+					SetReplacement(questionChoice).
+					SetUpsert(true))
+			}
+			if _, err := r.questionChoiceCollection.BulkWrite(sessionCtx, models); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// PublishQuestionGroup stores the revision, points the group at it and
+// publishes the draft choices in one transaction, so a failed publish
+// never leaves a revision the group does not reference.
+func (r *QuestionBankRepositoryMongo) PublishQuestionGroup(questionRevision *question.QuestionRevision) error {
+	return r.withTransaction(func(sessionCtx mongo.SessionContext) error {
+		if _, err := r.questionRevisionCollection.InsertOne(sessionCtx, questionRevision); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return fmt.Errorf("duplicate version")
+			}
+			return err
+		}
+
+		result, err := r.questionGroupCollection.UpdateOne(sessionCtx, bson.M{"_id": questionRevision.QuestionGroup}, bson.M{
+			"$set": bson.M{
+				"revision": questionRevision.ID,
+				"version":  questionRevision.Version,
+				"draft":    false,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if result.MatchedCount == 0 {
+			return fmt.Errorf("data not found")
+		}
+
+		_, err = r.questionChoiceCollection.UpdateMany(sessionCtx, bson.M{
+			"question_group": questionRevision.QuestionGroup,
+			"draft":          true,
+		}, bson.M{
+			"$set": bson.M{"draft": false},
+		})
+		return err
+	})
+}
+
+// RemoveQuestionGroup deletes a group together with its choices and
+// revisions, so a failure never leaves choices without their group. The
+// group is only removed while it has no records and no questionnaire uses
+// it, checked in the same transaction as the delete.
+func (r *QuestionBankRepositoryMongo) RemoveQuestionGroup(questionGroup primitive.ObjectID) error {
+	return r.withTransaction(func(sessionCtx mongo.SessionContext) error {
+		records, err := r.groupRecordCollection.CountDocuments(sessionCtx, bson.M{
+			"question_group": questionGroup,
+		}, options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+
+		if records > 0 {
+			return question.ErrQuestionGroupHasRecords
+		}
+
+		questionnaires, err := r.questionnaireCollection.CountDocuments(sessionCtx, bson.M{
+			"question_groups": questionGroup,
+		}, options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+
+		if questionnaires > 0 {
+			return question.ErrQuestionGroupInUse
+		}
+
+		if _, err := r.questionChoiceCollection.DeleteMany(sessionCtx, bson.M{"question_group": questionGroup}); err != nil {
+			return err
+		}
+
+		if _, err := r.questionRevisionCollection.DeleteMany(sessionCtx, bson.M{"question_group": questionGroup}); err != nil {
+			return err
+		}
+
+		result, err := r.questionGroupCollection.DeleteOne(sessionCtx, bson.M{"_id": questionGroup})
+		if err != nil {
+			return err
+		}
+
+		if result.DeletedCount == 0 {
+			return fmt.Errorf("data not found")
+		}
+
+		return nil
+	})
+}
+
+// RemoveQuestionChoice deletes a choice and marks its group as draft in one
+// transaction. The choice is only removed while no record answers it,
+// checked in the same transaction as the delete.
+func (r *QuestionBankRepositoryMongo) RemoveQuestionChoice(questionGroup, questionChoice primitive.ObjectID) error {
+	return r.withTransaction(func(sessionCtx mongo.SessionContext) error {
+		records, err := r.groupRecordCollection.CountDocuments(sessionCtx, bson.M{
+			"answers.choice": questionChoice,
+		}, options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+
+		if records > 0 {
+			return question.ErrQuestionChoiceHasRecords
+		}
+
+		result, err := r.questionChoiceCollection.DeleteOne(sessionCtx, bson.M{
+			"_id":            questionChoice,
+			"question_group": questionGroup,
+		})
+		if err != nil {
+			return err
+		}
+
+		if result.DeletedCount == 0 {
+			return fmt.Errorf("data not found")
+		}
+
+		updateResult, err := r.questionGroupCollection.UpdateOne(sessionCtx, bson.M{"_id": questionGroup}, bson.M{
+			"$set": bson.M{
+				"draft":      true,
+				"updated_at": time.Now(),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if updateResult.MatchedCount == 0 {
+			return fmt.Errorf("data not found")
+		}
+
+		return nil
+	})
+}
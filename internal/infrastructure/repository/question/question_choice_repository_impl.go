@@ -30,15 +30,16 @@ func (r *QuestionChoiceRepositoryMongo) CreateQuestionChoice(questionChoice *que
 	return err
 }
 
-func (r *QuestionChoiceRepositoryMongo) FindAllQuestionChoiceByQuestionGroup(questionGroup *primitive.ObjectID) (*[]question.QuestionChoice, error) {
+func (r *QuestionChoiceRepositoryMongo) FindAllQuestionChoiceByQuestionGroup(questionGroup *primitive.ObjectID, archived bool) (*[]question.QuestionChoice, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	opts := options.Find().
-		SetSort(bson.D{{Key: "created_at", Value: -1}})
+		SetSort(bson.D{{Key: "position", Value: 1}, {Key: "created_at", Value: -1}})
 
 	cursor, err := r.questionChoiceCollection.Find(ctx, bson.M{
 		"question_group": questionGroup,
+		"archived_at":    bson.M{"$exists": archived},
 	}, opts)
 	if err != nil {
 		return nil, err
@@ -54,7 +55,49 @@ func (r *QuestionChoiceRepositoryMongo) FindAllQuestionChoiceByQuestionGroup(que
 	return &questions, nil
 }
 
-func (r *QuestionChoiceRepositoryMongo) UpdateQuestionChoiceById(id, question string, shouldInvert bool) error {
+func (r *QuestionChoiceRepositoryMongo) FindQuestionChoices() (*[]question.QuestionChoice, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "question_group", Value: 1}, {Key: "created_at", Value: -1}})
+
+	cursor, err := r.questionChoiceCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	questions := make([]question.QuestionChoice, 0)
+	err = cursor.All(ctx, &questions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &questions, nil
+}
+
+func (r *QuestionChoiceRepositoryMongo) FindQuestionChoiceById(id string) (*question.QuestionChoice, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var questionChoice question.QuestionChoice
+	err = r.questionChoiceCollection.FindOne(ctx, bson.M{
+		"_id": objectID,
+	}).Decode(&questionChoice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &questionChoice, nil
+}
+
+func (r *QuestionChoiceRepositoryMongo) UpdateQuestionChoiceById(id, question string, shouldInvert, pinned bool, position int, translations map[string]question.QuestionChoiceText) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -67,6 +110,10 @@ func (r *QuestionChoiceRepositoryMongo) UpdateQuestionChoiceById(id, question st
 		"$set": bson.M{
 			"question":      question,
 			"should_invert": shouldInvert,
+			"pinned":        pinned,
+			"position":      position,
+			"translations":  translations,
+			"draft":         true,
 			"updated_at":    time.Now(),
 		},
 	}
@@ -87,7 +134,9 @@ func (r *QuestionChoiceRepositoryMongo) UpdateQuestionChoiceById(id, question st
 	return nil
 }
 
-func (r *QuestionChoiceRepositoryMongo) RemoveChoiceById(id string) error {
+// ArchiveChoiceById sets or, with a nil time, clears archived_at. Either way
+// the choice changes what the next revision of its group contains.
+func (r *QuestionChoiceRepositoryMongo) ArchiveChoiceById(id string, archivedAt *time.Time) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -96,34 +145,30 @@ func (r *QuestionChoiceRepositoryMongo) RemoveChoiceById(id string) error {
 		return err
 	}
 
-	filter := bson.M{
-		"_id": objectID,
+	update := bson.M{
+		"$set": bson.M{
+			"archived_at": archivedAt,
+			"draft":       true,
+			"updated_at":  time.Now(),
+		},
 	}
-
-	_, err = r.questionChoiceCollection.DeleteOne(ctx, filter)
-	if err != nil {
-		return err
+	if archivedAt == nil {
+		update = bson.M{
+			"$set": bson.M{
+				"draft":      true,
+				"updated_at": time.Now(),
+			},
+			"$unset": bson.M{"archived_at": ""},
+		}
 	}
 
-	return nil
-}
-
-func (r *QuestionChoiceRepositoryMongo) RemoveChoicesByQuestionGroupId(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	objectID, err := primitive.ObjectIDFromHex(id)
+	result, err := r.questionChoiceCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
 	if err != nil {
 		return err
 	}
 
-	filter := bson.M{
-		"question_group": objectID,
-	}
-
-	_, err = r.questionChoiceCollection.DeleteMany(ctx, filter)
-	if err != nil {
-		return err
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
 	}
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"mucb_be/internal/domain/question"
+	"mucb_be/internal/domain/schedule"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -30,13 +31,17 @@ func (r *QuestionGroupRepositoryMongo) CreateQuestionGroup(questionGroup *questi
 	return err
 }
 
-func (r *QuestionGroupRepositoryMongo) FindAllQuestionGroup(page, limit int) (*[]question.QuestionGroup, int, error) {
+func (r *QuestionGroupRepositoryMongo) FindAllQuestionGroup(page, limit int, archived bool) (*[]question.QuestionGroup, int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	offset := (page - 1) * limit
 
-	total, err := r.questionGroupCollection.CountDocuments(ctx, bson.M{})
+	filter := bson.M{
+		"archived_at": bson.M{"$exists": archived},
+	}
+
+	total, err := r.questionGroupCollection.CountDocuments(ctx, filter)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -46,7 +51,7 @@ func (r *QuestionGroupRepositoryMongo) FindAllQuestionGroup(page, limit int) (*[
 		SetLimit(int64(limit)).
 		SetSort(bson.M{"created_at": -1})
 
-	cursor, err := r.questionGroupCollection.Find(ctx, bson.M{}, opts)
+	cursor, err := r.questionGroupCollection.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -60,55 +65,48 @@ func (r *QuestionGroupRepositoryMongo) FindAllQuestionGroup(page, limit int) (*[
 	return &groups, int(total), nil
 }
 
-func (r *QuestionGroupRepositoryMongo) FindGroupsWithRandomChoices() (*[]question.GroupsWithRandomChoices, error) {
+func (r *QuestionGroupRepositoryMongo) FindQuestionGroups() (*[]question.QuestionGroup, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	pipeline := mongo.Pipeline{
-		{
-			{Key: "$lookup", Value: bson.D{
-				{Key: "from", Value: "question_choices"},
-				{Key: "let", Value: bson.D{
-					{Key: "groupId", Value: "$_id"},
-					{Key: "groupLimit", Value: "$limit"},
-				}},
-				{Key: "pipeline", Value: bson.A{
-					bson.D{{Key: "$match", Value: bson.D{
-						{Key: "$expr", Value: bson.D{
-							{Key: "$eq", Value: bson.A{"$question_group", "$$groupId"}},
-						}}},
-					}},
-					bson.D{{Key: "$sample", Value: bson.D{
-						{Key: "size", Value: 5}},
-					}},
-				}},
-				{Key: "as", Value: "choices"},
-			}},
-		},
-		{
-			{Key: "$set", Value: bson.D{
-				{Key: "choices", Value: bson.D{
-					{Key: "$slice", Value: bson.A{"$choices", "$limit"}}},
-				},
-			}},
-		},
+	opts := options.Find().
+		SetSort(bson.M{"created_at": 1})
+
+	cursor, err := r.questionGroupCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	groups := make([]question.QuestionGroup, 0)
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
 	}
 
-	cursor, err := r.questionGroupCollection.Aggregate(ctx, pipeline)
+	return &groups, nil
+}
+
+func (r *QuestionGroupRepositoryMongo) FindQuestionGroupById(id string) (*question.QuestionGroup, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return nil, err
 	}
 
-	var questionGroups []question.GroupsWithRandomChoices
-	if err := cursor.All(ctx, &questionGroups); err != nil {
-		fmt.Println("err", err)
+	var questionGroup question.QuestionGroup
+	err = r.questionGroupCollection.FindOne(ctx, bson.M{
+		"_id": objectID,
+	}).Decode(&questionGroup)
+	if err != nil {
 		return nil, err
 	}
 
-	return &questionGroups, nil
+	return &questionGroup, nil
 }
 
-func (r *QuestionGroupRepositoryMongo) RemoveQuestionGroupById(id string) error {
+func (r *QuestionGroupRepositoryMongo) UpdateQuestionGroupById(id string, columnName string, description string, limit int, groupSchedule *schedule.Schedule, scale *question.AnswerScale, sampling *question.Sampling, translations map[string]question.QuestionGroupText) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -117,39 +115,66 @@ func (r *QuestionGroupRepositoryMongo) RemoveQuestionGroupById(id string) error
 		return err
 	}
 
-	filter := bson.M{
-		"_id": objectID,
+	update := bson.M{
+		"$set": bson.M{
+			"column_name":  columnName,
+			"description":  description,
+			"limit":        limit,
+			"schedule":     groupSchedule,
+			"scale":        scale,
+			"sampling":     sampling,
+			"translations": translations,
+			"draft":        true,
+			"updated_at":   time.Now(),
+		},
 	}
 
-	_, err = r.questionGroupCollection.DeleteOne(ctx, filter)
+	result, err := r.questionGroupCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
 	if err != nil {
 		return err
 	}
 
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	if result.ModifiedCount == 0 {
+		return fmt.Errorf("can not update")
+	}
+
 	return nil
 }
 
-func (r *QuestionGroupRepositoryMongo) FindQuestionGroupById(id string) (*question.QuestionGroup, error) {
+func (r *QuestionGroupRepositoryMongo) UpdateQuestionGroupConditions(id string, conditions []question.Condition) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var questionGroup question.QuestionGroup
-	err = r.questionGroupCollection.FindOne(ctx, bson.M{
-		"_id": objectID,
-	}).Decode(&questionGroup)
+	update := bson.M{
+		"$set": bson.M{
+			"conditions": conditions,
+			"draft":      true,
+			"updated_at": time.Now(),
+		},
+	}
+
+	result, err := r.questionGroupCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &questionGroup, nil
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
 }
 
-func (r *QuestionGroupRepositoryMongo) UpdateQuestionGroupById(id string, columnName string, description string, limit int) error {
+func (r *QuestionGroupRepositoryMongo) MarkQuestionGroupDraft(id string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -160,10 +185,8 @@ func (r *QuestionGroupRepositoryMongo) UpdateQuestionGroupById(id string, column
 
 	update := bson.M{
 		"$set": bson.M{
-			"column_name": columnName,
-			"description": description,
-			"limit":       limit,
-			"updated_at":  time.Now(),
+			"draft":      true,
+			"updated_at": time.Now(),
 		},
 	}
 
@@ -176,8 +199,39 @@ func (r *QuestionGroupRepositoryMongo) UpdateQuestionGroupById(id string, column
 		return fmt.Errorf("data not found")
 	}
 
-	if result.ModifiedCount == 0 {
-		return fmt.Errorf("can not update")
+	return nil
+}
+
+// ArchiveQuestionGroupById sets or, with a nil time, clears archived_at.
+func (r *QuestionGroupRepositoryMongo) ArchiveQuestionGroupById(id string, archivedAt *time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"archived_at": archivedAt,
+			"updated_at":  time.Now(),
+		},
+	}
+	if archivedAt == nil {
+		update = bson.M{
+			"$set":   bson.M{"updated_at": time.Now()},
+			"$unset": bson.M{"archived_at": ""},
+		}
+	}
+
+	result, err := r.questionGroupCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
 	}
 
 	return nil
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"mucb_be/internal/domain/question"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type QuestionRevisionRepositoryMongo struct {
+	questionRevisionCollection *mongo.Collection
+}
+
+func NewQuestionRevisionRepositoryMongo(questionRevisionCollection *mongo.Collection) question.QuestionRevisionRepository {
+	return &QuestionRevisionRepositoryMongo{
+		questionRevisionCollection: questionRevisionCollection,
+	}
+}
+
+func (r *QuestionRevisionRepositoryMongo) FindQuestionRevisionById(id string) (*question.QuestionRevision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var questionRevision question.QuestionRevision
+	err = r.questionRevisionCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&questionRevision)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("data not found")
+		}
+		return nil, err
+	}
+
+	return &questionRevision, nil
+}
+
+func (r *QuestionRevisionRepositoryMongo) FindQuestionRevisionByVersion(questionGroup primitive.ObjectID, version int) (*question.QuestionRevision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var questionRevision question.QuestionRevision
+	err := r.questionRevisionCollection.FindOne(ctx, bson.M{
+		"question_group": questionGroup,
+		"version":        version,
+	}).Decode(&questionRevision)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("data not found")
+		}
+		return nil, err
+	}
+
+	return &questionRevision, nil
+}
+
+func (r *QuestionRevisionRepositoryMongo) FindQuestionRevisionsByQuestionGroup(questionGroup primitive.ObjectID) (*[]question.QuestionRevision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.M{"version": -1})
+
+	cursor, err := r.questionRevisionCollection.Find(ctx, bson.M{"question_group": questionGroup}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	questionRevisions := make([]question.QuestionRevision, 0)
+	if err := cursor.All(ctx, &questionRevisions); err != nil {
+		return nil, err
+	}
+
+	return &questionRevisions, nil
+}
+
+func (r *QuestionRevisionRepositoryMongo) FindLiveQuestionRevision(questionGroup primitive.ObjectID, at time.Time) (*question.QuestionRevision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.FindOne().SetSort(bson.M{"version": -1})
+
+	var questionRevision question.QuestionRevision
+	err := r.questionRevisionCollection.FindOne(ctx, bson.M{
+		"question_group": questionGroup,
+		"published_at":   bson.M{"$lte": at},
+	}, opts).Decode(&questionRevision)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("data not found")
+		}
+		return nil, err
+	}
+
+	return &questionRevision, nil
+}
+
+// FindLiveQuestionRevisions returns the revision participants see for every
+// question group that still exists and is not archived, in the order the
+// groups were created.
+func (r *QuestionRevisionRepositoryMongo) FindLiveQuestionRevisions(at time.Time) (*[]question.QuestionRevision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"published_at": bson.M{"$lte": at}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "question_group", Value: 1}, {Key: "version", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      "$question_group",
+			"revision": bson.M{"$first": "$$ROOT"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$revision"}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "question_groups",
+			"localField":   "question_group",
+			"foreignField": "_id",
+			"as":           "group",
+		}}},
+		{{Key: "$match", Value: bson.M{
+			"group.0":           bson.M{"$exists": true},
+			"group.archived_at": bson.M{"$exists": false},
+		}}},
+		{{Key: "$project", Value: bson.M{"group": 0}}},
+		{{Key: "$sort", Value: bson.M{"question_group": 1}}},
+	}
+
+	cursor, err := r.questionRevisionCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	questionRevisions := make([]question.QuestionRevision, 0)
+	if err := cursor.All(ctx, &questionRevisions); err != nil {
+		return nil, err
+	}
+
+	return &questionRevisions, nil
+}
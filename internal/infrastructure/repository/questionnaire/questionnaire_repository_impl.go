@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"mucb_be/internal/domain/questionnaire"
+	"mucb_be/internal/domain/schedule"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type QuestionnaireRepositoryMongo struct {
+	questionnaireCollection *mongo.Collection
+}
+
+func NewQuestionnaireRepositoryMongo(questionnaireCollection *mongo.Collection) questionnaire.QuestionnaireRepository {
+	return &QuestionnaireRepositoryMongo{
+		questionnaireCollection: questionnaireCollection,
+	}
+}
+
+func (r *QuestionnaireRepositoryMongo) CreateQuestionnaire(q *questionnaire.Questionnaire) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.questionnaireCollection.InsertOne(ctx, q)
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("duplicate questionnaire name")
+	}
+	return err
+}
+
+func (r *QuestionnaireRepositoryMongo) FindAllQuestionnaire(page, limit int) (*[]questionnaire.Questionnaire, int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	offset := (page - 1) * limit
+
+	total, err := r.questionnaireCollection.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := options.Find().
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit)).
+		SetSort(bson.M{"created_at": -1})
+
+	cursor, err := r.questionnaireCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	questionnaires := make([]questionnaire.Questionnaire, 0)
+	if err := cursor.All(ctx, &questionnaires); err != nil {
+		return nil, 0, err
+	}
+
+	return &questionnaires, int(total), nil
+}
+
+func (r *QuestionnaireRepositoryMongo) FindActiveQuestionnaires() (*[]questionnaire.Questionnaire, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.M{"created_at": 1})
+
+	cursor, err := r.questionnaireCollection.Find(ctx, bson.M{"active": true}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	questionnaires := make([]questionnaire.Questionnaire, 0)
+	if err := cursor.All(ctx, &questionnaires); err != nil {
+		return nil, err
+	}
+
+	return &questionnaires, nil
+}
+
+func (r *QuestionnaireRepositoryMongo) FindQuestionnaireById(id string) (*questionnaire.Questionnaire, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var q questionnaire.Questionnaire
+	err = r.questionnaireCollection.FindOne(ctx, bson.M{
+		"_id": objectID,
+	}).Decode(&q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &q, nil
+}
+
+func (r *QuestionnaireRepositoryMongo) UpdateQuestionnaireById(
+	id, name, description string,
+	questionGroups []primitive.ObjectID,
+	questionnaireSchedule *schedule.Schedule,
+	scoring questionnaire.Scoring,
+	active bool,
+) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"name":            name,
+			"description":     description,
+			"question_groups": questionGroups,
+			"schedule":        questionnaireSchedule,
+			"scoring":         scoring,
+			"active":          active,
+			"updated_at":      time.Now(),
+		},
+	}
+
+	result, err := r.questionnaireCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("duplicate questionnaire name")
+	}
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
+}
+
+func (r *QuestionnaireRepositoryMongo) RemoveQuestionnaireById(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.questionnaireCollection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
+}
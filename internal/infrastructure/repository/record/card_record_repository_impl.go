@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type CardRecordRepositoryMongo struct {
@@ -33,24 +34,15 @@ func (r *CardRecordRepositoryMongo) CreateManyGroupRecord(cardRecords *[]record.
 	return err
 }
 
-func (r *CardRecordRepositoryMongo) HasSubmittedToday(user primitive.ObjectID) (bool, error) {
+func (r *CardRecordRepositoryMongo) HasSubmittedBetween(user primitive.ObjectID, start, end time.Time) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	tz, _ := time.LoadLocation("Asia/Bangkok")
-	now := time.Now().In(tz)
-
-	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, tz)
-	endOfDay := time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 999999999, tz)
-
-	startOfDayUTC := startOfDay.UTC()
-	endOfDayUTC := endOfDay.UTC()
-
 	filter := bson.M{
 		"user": user,
 		"created_at": bson.M{
-			"$gte": startOfDayUTC,
-			"$lt":  endOfDayUTC,
+			"$gte": start.UTC(),
+			"$lt":  end.UTC(),
 		},
 	}
 
@@ -82,3 +74,208 @@ func (r *CardRecordRepositoryMongo) RemoveDataByUserId(id string) error {
 
 	return nil
 }
+
+// CountDailyRecords counts the user's records per calendar date in timezone
+// for records created within [start, end).
+func (r *CardRecordRepositoryMongo) CountDailyRecords(user primitive.ObjectID, start, end time.Time, timezone string) (map[string]int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"user": user,
+			"created_at": bson.M{
+				"$gte": start.UTC(),
+				"$lt":  end.UTC(),
+			},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"$dateToString": bson.M{
+					"format":   "%Y-%m-%d",
+					"date":     "$created_at",
+					"timezone": timezone,
+				},
+			},
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := r.cardRecordCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		Date  string `bson:"_id"`
+		Count int    `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Date] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *CardRecordRepositoryMongo) FindCardRecordsByUser(user primitive.ObjectID, limit int64) (*[]record.CardRecord, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(limit)
+
+	cursor, err := r.cardRecordCollection.Find(ctx, bson.M{"user": user}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	cardRecords := make([]record.CardRecord, 0)
+	err = cursor.All(ctx, &cardRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cardRecords, nil
+}
+
+func cardStatisticsMatch(filter record.CardStatisticsFilter) bson.M {
+	match := bson.M{
+		"created_at": bson.M{
+			"$gte": filter.Start.UTC(),
+			"$lt":  filter.End.UTC(),
+		},
+	}
+	if filter.GroupCode != nil {
+		match["group_code"] = *filter.GroupCode
+	}
+	return match
+}
+
+// CountCardPicks counts the picks of every card per period and cohort.
+func (r *CardRecordRepositoryMongo) CountCardPicks(filter record.CardStatisticsFilter) ([]record.CardPickCount, error) {
+	return r.countCardPicks(cardStatisticsMatch(filter), filter)
+}
+
+// CountUserCardPicks counts the picks of every card per period for one user.
+func (r *CardRecordRepositoryMongo) CountUserCardPicks(user primitive.ObjectID, filter record.CardStatisticsFilter) ([]record.CardPickCount, error) {
+	match := cardStatisticsMatch(filter)
+	match["user"] = user
+	return r.countCardPicks(match, filter)
+}
+
+func (r *CardRecordRepositoryMongo) countCardPicks(match bson.M, filter record.CardStatisticsFilter) ([]record.CardPickCount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.M{"created_at": 1}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"card": "$card",
+				"period": bson.M{
+					"$dateToString": bson.M{
+						"format":   record.PeriodFormats[filter.Interval],
+						"date":     "$created_at",
+						"timezone": filter.Timezone,
+					},
+				},
+				"group_code": "$group_code",
+			},
+			"card_name": bson.M{"$last": "$card_name"},
+			"count":     bson.M{"$sum": 1},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":        0,
+			"card":       "$_id.card",
+			"period":     "$_id.period",
+			"group_code": "$_id.group_code",
+			"card_name":  1,
+			"count":      1,
+		}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "period", Value: 1},
+			{Key: "count", Value: -1},
+		}}},
+	}
+
+	cursor, err := r.cardRecordCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	counts := make([]record.CardPickCount, 0)
+	if err := cursor.All(ctx, &counts); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// CountCardPairs counts how often two cards were picked in the same
+// submission. The records of one submission share their user and created_at.
+func (r *CardRecordRepositoryMongo) CountCardPairs(filter record.CardStatisticsFilter, limit int) ([]record.CardPairCount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: cardStatisticsMatch(filter)}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"user":       "$user",
+				"created_at": "$created_at",
+			},
+			"cards": bson.M{"$addToSet": "$card"},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"first":  "$cards",
+			"second": "$cards",
+		}}},
+		{{Key: "$unwind", Value: "$first"}},
+		{{Key: "$unwind", Value: "$second"}},
+		{{Key: "$match", Value: bson.M{
+			"$expr": bson.M{"$lt": bson.A{"$first", "$second"}},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"first":  "$first",
+				"second": "$second",
+			},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":    0,
+			"first":  "$_id.first",
+			"second": "$_id.second",
+			"count":  1,
+		}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "count", Value: -1},
+			{Key: "first", Value: 1},
+			{Key: "second", Value: 1},
+		}}},
+		{{Key: "$limit", Value: limit}},
+	}
+
+	cursor, err := r.cardRecordCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	pairs := make([]record.CardPairCount, 0)
+	if err := cursor.All(ctx, &pairs); err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type GroupRecordRepositoryMongo struct {
@@ -33,24 +34,52 @@ func (r *GroupRecordRepositoryMongo) CreateManyGroupRecord(questionGroup *[]reco
 	return err
 }
 
-func (r *GroupRecordRepositoryMongo) HasSubmittedToday(user primitive.ObjectID) (bool, error) {
+func (r *GroupRecordRepositoryMongo) HasSubmittedBetween(user, questionGroup primitive.ObjectID, start, end time.Time) (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	tz, _ := time.LoadLocation("Asia/Bangkok")
-	now := time.Now().In(tz)
+	filter := bson.M{
+		"user":           user,
+		"question_group": questionGroup,
+		"created_at": bson.M{
+			"$gte": start.UTC(),
+			"$lt":  end.UTC(),
+		},
+	}
+
+	count, err := r.groupRecordCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
 
-	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, tz)
-	endOfDay := time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 999999999, tz)
+func (r *GroupRecordRepositoryMongo) CountGroupRecords(user, questionGroup primitive.ObjectID) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	count, err := r.groupRecordCollection.CountDocuments(ctx, bson.M{
+		"user":           user,
+		"question_group": questionGroup,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
 
-	startOfDayUTC := startOfDay.UTC()
-	endOfDayUTC := endOfDay.UTC()
+func (r *GroupRecordRepositoryMongo) HasSubmittedQuestionnaireBetween(user, questionnaire primitive.ObjectID, start, end time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
 
 	filter := bson.M{
-		"user": user,
+		"user":          user,
+		"questionnaire": questionnaire,
 		"created_at": bson.M{
-			"$gte": startOfDayUTC,
-			"$lt":  endOfDayUTC,
+			"$gte": start.UTC(),
+			"$lt":  end.UTC(),
 		},
 	}
 
@@ -82,3 +111,74 @@ func (r *GroupRecordRepositoryMongo) RemoveDataByUserId(id string) error {
 
 	return nil
 }
+
+// CountDailyRecords counts the user's records per calendar date in timezone
+// for records created within [start, end).
+func (r *GroupRecordRepositoryMongo) CountDailyRecords(user primitive.ObjectID, start, end time.Time, timezone string) (map[string]int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"user": user,
+			"created_at": bson.M{
+				"$gte": start.UTC(),
+				"$lt":  end.UTC(),
+			},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"$dateToString": bson.M{
+					"format":   "%Y-%m-%d",
+					"date":     "$created_at",
+					"timezone": timezone,
+				},
+			},
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := r.groupRecordCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		Date  string `bson:"_id"`
+		Count int    `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Date] = row.Count
+	}
+
+	return counts, nil
+}
+
+func (r *GroupRecordRepositoryMongo) FindGroupRecordsByUser(user primitive.ObjectID, limit int64) (*[]record.GroupRecord, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(limit)
+
+	cursor, err := r.groupRecordCollection.Find(ctx, bson.M{"user": user}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	groupRecords := make([]record.GroupRecord, 0)
+	err = cursor.All(ctx, &groupRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupRecords, nil
+}
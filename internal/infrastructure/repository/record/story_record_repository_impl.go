@@ -3,20 +3,24 @@ package repository
 import (
 	"context"
 	"mucb_be/internal/domain/record"
+	"mucb_be/internal/infrastructure/security"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type StoryRecordRepositoryMongo struct {
-	storyRecordCollection *mongo.Collection
+	storyRecordCollection  *mongo.Collection
+	fieldEncryptionService security.FieldEncryptionServiceInterface
 }
 
-func NewStoryRecordRepositoryMongo(storyRecordCollection *mongo.Collection) record.StoryRecordRepository {
+func NewStoryRecordRepositoryMongo(storyRecordCollection *mongo.Collection, fieldEncryptionService security.FieldEncryptionServiceInterface) record.StoryRecordRepository {
 	return &StoryRecordRepositoryMongo{
-		storyRecordCollection: storyRecordCollection,
+		storyRecordCollection:  storyRecordCollection,
+		fieldEncryptionService: fieldEncryptionService,
 	}
 }
 
@@ -24,10 +28,57 @@ func (r *StoryRecordRepositoryMongo) CreateStoryRecord(storyRecord *record.Story
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := r.storyRecordCollection.InsertOne(ctx, storyRecord)
+	encryptedContent, err := r.fieldEncryptionService.Encrypt(storyRecord.Content)
+	if err != nil {
+		return err
+	}
+
+	document := *storyRecord
+	document.Content = encryptedContent
+
+	_, err = r.storyRecordCollection.InsertOne(ctx, document)
 	return err
 }
 
+func (r *StoryRecordRepositoryMongo) FindFlaggedStoryRecords(limit int64) (*[]record.StoryRecord, error) {
+	return r.findStoryRecords(bson.M{"flagged": true}, limit)
+}
+
+func (r *StoryRecordRepositoryMongo) FindFlaggedStoryRecordsByUser(user primitive.ObjectID, limit int64) (*[]record.StoryRecord, error) {
+	return r.findStoryRecords(bson.M{"flagged": true, "user": user}, limit)
+}
+
+func (r *StoryRecordRepositoryMongo) findStoryRecords(filter bson.M, limit int64) (*[]record.StoryRecord, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetLimit(limit)
+
+	cursor, err := r.storyRecordCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	storyRecords := make([]record.StoryRecord, 0)
+	err = cursor.All(ctx, &storyRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range storyRecords {
+		content, err := r.fieldEncryptionService.Decrypt(storyRecords[index].Content)
+		if err != nil {
+			return nil, err
+		}
+		storyRecords[index].Content = content
+	}
+
+	return &storyRecords, nil
+}
+
 // RemoveDataByUserId implements record.StoryRecordRepository.
 func (r *StoryRecordRepositoryMongo) RemoveDataByUserId(id string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -49,3 +100,51 @@ func (r *StoryRecordRepositoryMongo) RemoveDataByUserId(id string) error {
 
 	return nil
 }
+
+// CountDailyRecords counts the user's records per calendar date in timezone
+// for records created within [start, end).
+func (r *StoryRecordRepositoryMongo) CountDailyRecords(user primitive.ObjectID, start, end time.Time, timezone string) (map[string]int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"user": user,
+			"created_at": bson.M{
+				"$gte": start.UTC(),
+				"$lt":  end.UTC(),
+			},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"$dateToString": bson.M{
+					"format":   "%Y-%m-%d",
+					"date":     "$created_at",
+					"timezone": timezone,
+				},
+			},
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+
+	cursor, err := r.storyRecordCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		Date  string `bson:"_id"`
+		Count int    `bson:"count"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Date] = row.Count
+	}
+
+	return counts, nil
+}
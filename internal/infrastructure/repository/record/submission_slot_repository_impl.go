@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"mucb_be/internal/domain/record"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SubmissionSlotRepositoryMongo struct {
+	submissionSlotCollection *mongo.Collection
+}
+
+func NewSubmissionSlotRepositoryMongo(submissionSlotCollection *mongo.Collection) record.SubmissionSlotRepository {
+	return &SubmissionSlotRepositoryMongo{
+		submissionSlotCollection: submissionSlotCollection,
+	}
+}
+
+// CreateManySubmissionSlot inserts every slot or none of them: when one slot
+// is already taken the slots inserted before it are removed again.
+func (r *SubmissionSlotRepositoryMongo) CreateManySubmissionSlot(submissionSlots *[]record.SubmissionSlot) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var slots []interface{}
+	for _, s := range *submissionSlots {
+		slots = append(slots, s)
+	}
+
+	_, err := r.submissionSlotCollection.InsertMany(ctx, slots, options.InsertMany().SetOrdered(true))
+	if err == nil {
+		return nil
+	}
+
+	var ids []primitive.ObjectID
+	for _, s := range *submissionSlots {
+		ids = append(ids, s.ID)
+	}
+	_, _ = r.submissionSlotCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+
+	if mongo.IsDuplicateKeyError(err) {
+		return record.ErrAlreadySubmitted
+	}
+
+	return err
+}
+
+func (r *SubmissionSlotRepositoryMongo) RemoveManySubmissionSlotById(ids []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var objectIDs []primitive.ObjectID
+	for _, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return err
+		}
+		objectIDs = append(objectIDs, objectID)
+	}
+
+	_, err := r.submissionSlotCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": objectIDs}})
+	return err
+}
+
+func (r *SubmissionSlotRepositoryMongo) RemoveDataByUserId(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{
+		"user": objectID,
+	}
+
+	_, err = r.submissionSlotCollection.DeleteMany(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
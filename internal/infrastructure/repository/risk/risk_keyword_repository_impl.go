@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"mucb_be/internal/domain/risk"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type RiskKeywordRepositoryMongo struct {
+	riskKeywordCollection *mongo.Collection
+}
+
+func NewRiskKeywordRepositoryMongo(riskKeywordCollection *mongo.Collection) risk.RiskKeywordRepository {
+	return &RiskKeywordRepositoryMongo{
+		riskKeywordCollection: riskKeywordCollection,
+	}
+}
+
+func (r *RiskKeywordRepositoryMongo) CreateRiskKeyword(riskKeyword *risk.RiskKeyword) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := r.riskKeywordCollection.InsertOne(ctx, riskKeyword)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return risk.ErrDuplicatePhrase
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *RiskKeywordRepositoryMongo) FindAllRiskKeywords() (*[]risk.RiskKeyword, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "language", Value: 1}, {Key: "weight", Value: -1}})
+
+	cursor, err := r.riskKeywordCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	riskKeywords := make([]risk.RiskKeyword, 0)
+	err = cursor.All(ctx, &riskKeywords)
+	if err != nil {
+		return nil, err
+	}
+
+	return &riskKeywords, nil
+}
+
+func (r *RiskKeywordRepositoryMongo) FindRiskKeywordById(id string) (*risk.RiskKeyword, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var riskKeyword risk.RiskKeyword
+	err = r.riskKeywordCollection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&riskKeyword)
+	if err != nil {
+		return nil, err
+	}
+
+	return &riskKeyword, nil
+}
+
+func (r *RiskKeywordRepositoryMongo) UpdateRiskKeywordById(id, phrase, language string, weight int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"phrase":     phrase,
+			"language":   language,
+			"weight":     weight,
+			"updated_at": time.Now(),
+		},
+	}
+
+	result, err := r.riskKeywordCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return risk.ErrDuplicatePhrase
+		}
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
+}
+
+func (r *RiskKeywordRepositoryMongo) RemoveRiskKeywordById(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.riskKeywordCollection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("data not found")
+	}
+
+	return nil
+}
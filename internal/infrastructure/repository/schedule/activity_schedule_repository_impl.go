@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"mucb_be/internal/domain/schedule"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ActivityScheduleRepositoryMongo struct {
+	activityScheduleCollection *mongo.Collection
+}
+
+func NewActivityScheduleRepositoryMongo(activityScheduleCollection *mongo.Collection) schedule.ActivityScheduleRepository {
+	return &ActivityScheduleRepositoryMongo{
+		activityScheduleCollection: activityScheduleCollection,
+	}
+}
+
+func (r *ActivityScheduleRepositoryMongo) FindActivitySchedule(activity string) (*schedule.ActivitySchedule, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var result schedule.ActivitySchedule
+	err := r.activityScheduleCollection.FindOne(ctx, bson.M{"activity": activity}).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (r *ActivityScheduleRepositoryMongo) UpsertActivitySchedule(activity string, activitySchedule *schedule.Schedule) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	update := bson.M{
+		"$set": bson.M{
+			"schedule":   activitySchedule,
+			"updated_at": time.Now(),
+		},
+		"$setOnInsert": bson.M{
+			"_id":        primitive.NewObjectID(),
+			"created_at": time.Now(),
+		},
+	}
+
+	_, err := r.activityScheduleCollection.UpdateOne(
+		ctx,
+		bson.M{"activity": activity},
+		update,
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
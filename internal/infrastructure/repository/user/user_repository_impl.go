@@ -3,20 +3,24 @@ package repository
 import (
 	"context"
 	"mucb_be/internal/domain/user"
+	"mucb_be/internal/infrastructure/security"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type UserRepositoryMongo struct {
-	userCollection *mongo.Collection
+	userCollection         *mongo.Collection
+	fieldEncryptionService security.FieldEncryptionServiceInterface
 }
 
-func NewUserRepositoryMongo(userCollection *mongo.Collection) user.UserRepository {
+func NewUserRepositoryMongo(userCollection *mongo.Collection, fieldEncryptionService security.FieldEncryptionServiceInterface) user.UserRepository {
 	return &UserRepositoryMongo{
-		userCollection: userCollection,
+		userCollection:         userCollection,
+		fieldEncryptionService: fieldEncryptionService,
 	}
 }
 
@@ -24,16 +28,44 @@ func (r *UserRepositoryMongo) CreateUser(user *user.User) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := r.userCollection.InsertOne(ctx, user)
-	return err
+	encryptedPhoneNumber, err := r.fieldEncryptionService.Encrypt(user.PhoneNumber)
+	if err != nil {
+		return err
+	}
+
+	// ✅ Store a copy so the caller keeps working with the plaintext number
+	document := *user
+	document.PhoneNumber = encryptedPhoneNumber
+	document.PhoneIndex = r.fieldEncryptionService.BlindIndex(user.PhoneNumber)
+
+	_, err = r.userCollection.InsertOne(ctx, document)
+	if err != nil {
+		return err
+	}
+
+	user.PhoneIndex = document.PhoneIndex
+	return nil
 }
 
 func (r *UserRepositoryMongo) FindUserByPhoneNumber(phoneNumber string) (*user.User, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// Documents not yet migrated still hold the plaintext number.
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"phone_number_index": r.fieldEncryptionService.BlindIndex(phoneNumber)},
+			bson.M{"phone_number": phoneNumber},
+		},
+	}
+
 	var result user.User
-	err := r.userCollection.FindOne(ctx, bson.M{"phone_number": phoneNumber}).Decode(&result)
+	err := r.userCollection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.decryptUser(&result)
 	if err != nil {
 		return nil, err
 	}
@@ -54,10 +86,15 @@ func (r *UserRepositoryMongo) FindUserById(id string) (*user.User, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	err = r.decryptUser(&result)
+	if err != nil {
+		return nil, err
+	}
 	return &result, nil
 }
 
-func (r *UserRepositoryMongo) UpdateUserInfo(id, name, group string) error {
+func (r *UserRepositoryMongo) UpdateUserInfo(id, name, group, timezone, language string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -70,6 +107,8 @@ func (r *UserRepositoryMongo) UpdateUserInfo(id, name, group string) error {
 		"$set": bson.M{
 			"name":       name,
 			"group_code": group,
+			"timezone":   timezone,
+			"language":   language,
 			"state":      user.UserStateActive,
 			"updated_at": time.Now(),
 		},
@@ -83,6 +122,70 @@ func (r *UserRepositoryMongo) UpdateUserInfo(id, name, group string) error {
 	return nil
 }
 
+func (r *UserRepositoryMongo) UpdateCounsellorConsent(id string, consent bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"counsellor_consent": consent,
+			"updated_at":         time.Now(),
+		},
+	}
+
+	_, err = r.userCollection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *UserRepositoryMongo) FindUsersByIdsOrGroupCodes(ids []primitive.ObjectID, groupCodes []string) (*[]user.User, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	users := make([]user.User, 0)
+	if len(ids) == 0 && len(groupCodes) == 0 {
+		return &users, nil
+	}
+
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"_id": bson.M{"$in": ids}},
+			bson.M{"group_code": bson.M{"$in": groupCodes}},
+		},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.userCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	err = cursor.All(ctx, &users)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range users {
+		err = r.decryptUser(&users[index])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &users, nil
+}
+
 func (r *UserRepositoryMongo) RemoveUserById(id string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -100,3 +203,13 @@ func (r *UserRepositoryMongo) RemoveUserById(id string) error {
 
 	return nil
 }
+
+func (r *UserRepositoryMongo) decryptUser(result *user.User) error {
+	phoneNumber, err := r.fieldEncryptionService.Decrypt(result.PhoneNumber)
+	if err != nil {
+		return err
+	}
+
+	result.PhoneNumber = phoneNumber
+	return nil
+}
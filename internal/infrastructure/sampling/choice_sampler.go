@@ -0,0 +1,52 @@
+package sampling
+
+import (
+	"hash/fnv"
+	"mucb_be/internal/domain/question"
+	"mucb_be/internal/domain/record"
+	"mucb_be/internal/domain/user"
+	"time"
+)
+
+type ChoiceSamplerInterface interface {
+	Sample(questionRevision *question.QuestionRevision, participant *user.User, at time.Time) (*question.GroupsWithRandomChoices, error)
+}
+
+type ChoiceSampler struct {
+	groupRecordRepo record.GroupRecordRepository
+}
+
+func NewChoiceSampler(groupRecordRepo record.GroupRecordRepository) ChoiceSamplerInterface {
+	return &ChoiceSampler{
+		groupRecordRepo: groupRecordRepo,
+	}
+}
+
+// Sample draws the exam choices of a revision for one participant. The seed
+// changes at midnight in the participant's timezone so a refresh on the same
+// day shows the same choices, and round-robin groups continue from the number
+// of times the participant has answered the group.
+func (s *ChoiceSampler) Sample(questionRevision *question.QuestionRevision, participant *user.User, at time.Time) (*question.GroupsWithRandomChoices, error) {
+	draw := question.ChoiceDraw{
+		Seed: seed(participant, questionRevision, at),
+	}
+
+	if questionRevision.Sampling != nil && questionRevision.Sampling.Strategy == question.SamplingRoundRobin {
+		served, err := s.groupRecordRepo.CountGroupRecords(participant.ID, questionRevision.QuestionGroup)
+		if err != nil {
+			return nil, err
+		}
+		draw.Served = served
+	}
+
+	groupWithChoices := question.NewGroupsWithRandomChoices(questionRevision, draw)
+	return &groupWithChoices, nil
+}
+
+func seed(participant *user.User, questionRevision *question.QuestionRevision, at time.Time) int64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(participant.ID.Hex()))
+	hash.Write([]byte(questionRevision.QuestionGroup.Hex()))
+	hash.Write([]byte(at.In(participant.Location()).Format("2006-01-02")))
+	return int64(hash.Sum64())
+}
@@ -0,0 +1,122 @@
+package scheduling
+
+import (
+	"mucb_be/internal/domain/question"
+	"mucb_be/internal/domain/questionnaire"
+	"mucb_be/internal/domain/record"
+	"mucb_be/internal/domain/schedule"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type AvailabilityServiceInterface interface {
+	CardSchedule() (*schedule.Schedule, error)
+	GroupAvailability(user primitive.ObjectID, questionGroup *question.QuestionGroup, at time.Time, location *time.Location) (*schedule.Availability, error)
+	CardAvailability(user primitive.ObjectID, at time.Time, location *time.Location) (*schedule.Availability, error)
+	QuestionnaireAvailability(user primitive.ObjectID, q *questionnaire.Questionnaire, at time.Time, location *time.Location) (*schedule.Availability, error)
+}
+
+type AvailabilityService struct {
+	scheduleService      ScheduleServiceInterface
+	activityScheduleRepo schedule.ActivityScheduleRepository
+	groupRecordRepo      record.GroupRecordRepository
+	cardRecordRepo       record.CardRecordRepository
+}
+
+func NewAvailabilityService(
+	scheduleService ScheduleServiceInterface,
+	activityScheduleRepo schedule.ActivityScheduleRepository,
+	groupRecordRepo record.GroupRecordRepository,
+	cardRecordRepo record.CardRecordRepository,
+) AvailabilityServiceInterface {
+	return &AvailabilityService{
+		scheduleService:      scheduleService,
+		activityScheduleRepo: activityScheduleRepo,
+		groupRecordRepo:      groupRecordRepo,
+		cardRecordRepo:       cardRecordRepo,
+	}
+}
+
+// CardSchedule returns the schedule of the card activity, falling back to
+// once per day when no schedule has been configured.
+func (s *AvailabilityService) CardSchedule() (*schedule.Schedule, error) {
+	activitySchedule, err := s.activityScheduleRepo.FindActivitySchedule(schedule.ActivityCard)
+	if err == mongo.ErrNoDocuments {
+		return schedule.DefaultSchedule(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &activitySchedule.Schedule, nil
+}
+
+func (s *AvailabilityService) GroupAvailability(user primitive.ObjectID, questionGroup *question.QuestionGroup, at time.Time, location *time.Location) (*schedule.Availability, error) {
+	groupSchedule := questionGroup.Schedule
+	if groupSchedule == nil {
+		groupSchedule = schedule.DefaultSchedule()
+	}
+
+	return s.availability(groupSchedule, at, location, func(window *schedule.Window) (bool, error) {
+		return s.groupRecordRepo.HasSubmittedBetween(user, questionGroup.ID, window.StartAt, window.EndAt)
+	})
+}
+
+func (s *AvailabilityService) CardAvailability(user primitive.ObjectID, at time.Time, location *time.Location) (*schedule.Availability, error) {
+	cardSchedule, err := s.CardSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.availability(cardSchedule, at, location, func(window *schedule.Window) (bool, error) {
+		return s.cardRecordRepo.HasSubmittedBetween(user, window.StartAt, window.EndAt)
+	})
+}
+
+func (s *AvailabilityService) QuestionnaireAvailability(user primitive.ObjectID, q *questionnaire.Questionnaire, at time.Time, location *time.Location) (*schedule.Availability, error) {
+	questionnaireSchedule := q.Schedule
+	if questionnaireSchedule == nil {
+		questionnaireSchedule = schedule.DefaultSchedule()
+	}
+
+	return s.availability(questionnaireSchedule, at, location, func(window *schedule.Window) (bool, error) {
+		return s.groupRecordRepo.HasSubmittedQuestionnaireBetween(user, q.ID, window.StartAt, window.EndAt)
+	})
+}
+
+func (s *AvailabilityService) availability(
+	sc *schedule.Schedule,
+	at time.Time,
+	location *time.Location,
+	hasSubmitted func(window *schedule.Window) (bool, error),
+) (*schedule.Availability, error) {
+	currentWindow, ok := s.scheduleService.CurrentWindow(sc, at, location)
+	if ok {
+		isAlreadySubmit, err := hasSubmitted(currentWindow)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isAlreadySubmit {
+			return &schedule.Availability{
+				Available:     true,
+				CurrentWindow: currentWindow,
+			}, nil
+		}
+	}
+
+	after := at
+	if currentWindow != nil {
+		after = currentWindow.EndAt
+	}
+
+	nextWindow, _ := s.scheduleService.NextWindow(sc, after, location)
+
+	return &schedule.Availability{
+		Available:     false,
+		CurrentWindow: currentWindow,
+		NextWindow:    nextWindow,
+	}, nil
+}
@@ -0,0 +1,214 @@
+package scheduling
+
+import (
+	"errors"
+	"fmt"
+	"mucb_be/internal/domain/schedule"
+	"sort"
+	"time"
+)
+
+// maxLookAheadDays bounds the search for the next window so a schedule that
+// never opens again (e.g. weekdays outside its date range) cannot loop forever.
+const maxLookAheadDays = 400
+
+var (
+	ErrScheduleInvalidType     = errors.New("invalid schedule type")
+	ErrScheduleInvalidWindow   = errors.New("invalid schedule window")
+	ErrScheduleMissingWeekdays = errors.New("weekly schedule requires weekdays")
+	ErrScheduleInvalidRange    = errors.New("invalid schedule date range")
+)
+
+type ScheduleServiceInterface interface {
+	Validate(s *schedule.Schedule) error
+	CurrentWindow(s *schedule.Schedule, at time.Time, location *time.Location) (*schedule.Window, bool)
+	NextWindow(s *schedule.Schedule, after time.Time, location *time.Location) (*schedule.Window, bool)
+}
+
+type ScheduleService struct{}
+
+func NewScheduleService() ScheduleServiceInterface {
+	return &ScheduleService{}
+}
+
+func (s *ScheduleService) Validate(sc *schedule.Schedule) error {
+	if sc.StartDate != nil && sc.EndDate != nil && !sc.EndDate.After(*sc.StartDate) {
+		return ErrScheduleInvalidRange
+	}
+
+	switch sc.Type {
+	case schedule.TypeDaily:
+		return nil
+	case schedule.TypeTimesPerDay:
+		if len(sc.Windows) == 0 && sc.TimesPerDay < 1 {
+			return fmt.Errorf("%w: windows or timesPerDay is required", ErrScheduleInvalidWindow)
+		}
+		type span struct{ start, end time.Duration }
+		spans := make([]span, 0, len(sc.Windows))
+		for _, window := range sc.Windows {
+			start, err := parseClock(window.Start)
+			if err != nil {
+				return err
+			}
+			end, err := parseClock(window.End)
+			if err != nil {
+				return err
+			}
+			if end <= start {
+				return fmt.Errorf("%w: %s-%s", ErrScheduleInvalidWindow, window.Start, window.End)
+			}
+			spans = append(spans, span{start, end})
+		}
+		// ✅ Overlapping windows would let one submission count for two slots
+		sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+		for i := 1; i < len(spans); i++ {
+			if spans[i].start < spans[i-1].end {
+				return fmt.Errorf("%w: windows overlap", ErrScheduleInvalidWindow)
+			}
+		}
+		return nil
+	case schedule.TypeWeekly:
+		if len(sc.Weekdays) == 0 {
+			return ErrScheduleMissingWeekdays
+		}
+		return nil
+	case schedule.TypeOneOff:
+		if sc.StartDate == nil || sc.EndDate == nil {
+			return ErrScheduleInvalidRange
+		}
+		return nil
+	default:
+		return ErrScheduleInvalidType
+	}
+}
+
+// CurrentWindow returns the window that contains at, if any.
+func (s *ScheduleService) CurrentWindow(sc *schedule.Schedule, at time.Time, location *time.Location) (*schedule.Window, bool) {
+	window, ok := s.firstWindowEndingAfter(sc, at, location)
+	if !ok || window.StartAt.After(at) {
+		return nil, false
+	}
+
+	return window, true
+}
+
+// NextWindow returns the first window that starts at or after after.
+func (s *ScheduleService) NextWindow(sc *schedule.Schedule, after time.Time, location *time.Location) (*schedule.Window, bool) {
+	from := after
+	for i := 0; i < maxLookAheadDays*24; i++ {
+		window, ok := s.firstWindowEndingAfter(sc, from, location)
+		if !ok {
+			return nil, false
+		}
+		if !window.StartAt.Before(after) {
+			return window, true
+		}
+		from = window.EndAt
+	}
+
+	return nil, false
+}
+
+func (s *ScheduleService) firstWindowEndingAfter(sc *schedule.Schedule, at time.Time, location *time.Location) (*schedule.Window, bool) {
+	if sc.Type == schedule.TypeOneOff {
+		if sc.StartDate == nil || sc.EndDate == nil || !sc.EndDate.After(at) {
+			return nil, false
+		}
+		return &schedule.Window{
+			SlotKey: schedule.TypeOneOff,
+			StartAt: *sc.StartDate,
+			EndAt:   *sc.EndDate,
+		}, true
+	}
+
+	local := at.In(location)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+	for i := 0; i < maxLookAheadDays; i++ {
+		for _, window := range s.windowsOfDay(sc, day.AddDate(0, 0, i)) {
+			if !window.EndAt.After(at) {
+				continue
+			}
+			if sc.EndDate != nil && !window.StartAt.Before(*sc.EndDate) {
+				return nil, false
+			}
+			if sc.StartDate != nil && window.EndAt.Before(*sc.StartDate) {
+				continue
+			}
+			return window, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *ScheduleService) windowsOfDay(sc *schedule.Schedule, day time.Time) []*schedule.Window {
+	date := day.Format(time.DateOnly)
+	nextDay := day.AddDate(0, 0, 1)
+
+	switch sc.Type {
+	case schedule.TypeWeekly:
+		for _, weekday := range sc.Weekdays {
+			if time.Weekday(weekday) == day.Weekday() {
+				return []*schedule.Window{{SlotKey: date, StartAt: day, EndAt: nextDay}}
+			}
+		}
+		return nil
+	case schedule.TypeTimesPerDay:
+		var windows []*schedule.Window
+		if len(sc.Windows) > 0 {
+			for index, window := range sc.Windows {
+				start, errStart := parseClock(window.Start)
+				end, errEnd := parseClock(window.End)
+				if errStart != nil || errEnd != nil {
+					continue
+				}
+				windows = append(windows, &schedule.Window{
+					SlotKey: fmt.Sprintf("%s#%d", date, index+1),
+					StartAt: wallClock(day, start),
+					EndAt:   wallClock(day, end),
+				})
+			}
+			// ✅ Slot keys keep the stored index, but windows are scanned by start time
+			sort.SliceStable(windows, func(i, j int) bool { return windows[i].StartAt.Before(windows[j].StartAt) })
+			return windows
+		}
+
+		// ✅ Without explicit windows the day is split into equal parts of the
+		// clock, so a DST change only stretches or shrinks the window it falls in
+		length := 24 * time.Hour / time.Duration(sc.TimesPerDay)
+		for index := 0; index < sc.TimesPerDay; index++ {
+			end := wallClock(day, time.Duration(index+1)*length)
+			if index == sc.TimesPerDay-1 {
+				end = nextDay
+			}
+			windows = append(windows, &schedule.Window{
+				SlotKey: fmt.Sprintf("%s#%d", date, index+1),
+				StartAt: wallClock(day, time.Duration(index)*length),
+				EndAt:   end,
+			})
+		}
+		return windows
+	default:
+		return []*schedule.Window{{SlotKey: date, StartAt: day, EndAt: nextDay}}
+	}
+}
+
+// wallClock returns the time that the clock on day shows offset after
+// midnight. Adding the offset to midnight would be off by an hour on days
+// with a DST change.
+func wallClock(day time.Time, offset time.Duration) time.Time {
+	year, month, date := day.Date()
+	return time.Date(year, month, date, 0, 0, int(offset/time.Second), 0, day.Location())
+}
+
+func parseClock(value string) (time.Duration, error) {
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		if value == "24:00" {
+			return 24 * time.Hour, nil
+		}
+		return 0, fmt.Errorf("%w: %s", ErrScheduleInvalidWindow, value)
+	}
+
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute, nil
+}
@@ -0,0 +1,126 @@
+package screening
+
+import (
+	"mucb_be/internal/config"
+	"mucb_be/internal/domain/risk"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const defaultRiskFlagThreshold = 10
+
+type RiskDetectionServiceInterface interface {
+	Assess(content string) (*risk.Assessment, error)
+}
+
+type RiskDetectionService struct {
+	cfg             *config.Config
+	riskKeywordRepo risk.RiskKeywordRepository
+}
+
+func NewRiskDetectionService(cfg *config.Config, riskKeywordRepo risk.RiskKeywordRepository) RiskDetectionServiceInterface {
+	return &RiskDetectionService{
+		cfg:             cfg,
+		riskKeywordRepo: riskKeywordRepo,
+	}
+}
+
+// Assess scores content by summing the weights of every keyword it contains.
+// Each keyword counts once no matter how often it appears.
+func (s *RiskDetectionService) Assess(content string) (*risk.Assessment, error) {
+	threshold := defaultRiskFlagThreshold
+	if s.cfg.RiskFlagThreshold != "" {
+		value, err := strconv.Atoi(s.cfg.RiskFlagThreshold)
+		if err != nil {
+			return nil, err
+		}
+		threshold = value
+	}
+
+	riskKeywords, err := s.riskKeywordRepo.FindAllRiskKeywords()
+	if err != nil {
+		return nil, err
+	}
+
+	text := normalize(content)
+	assessment := risk.Assessment{
+		Keywords: make([]string, 0),
+	}
+	for _, riskKeyword := range *riskKeywords {
+		phrase := normalize(riskKeyword.Phrase)
+		if phrase == "" {
+			continue
+		}
+
+		matched := false
+		if riskKeyword.Language == risk.LanguageThai {
+			// ✅ Thai is written without spaces between words
+			matched = strings.Contains(text, phrase)
+		} else {
+			matched = containsWord(text, phrase)
+		}
+
+		if matched {
+			assessment.Score += riskKeyword.Weight
+			assessment.Keywords = append(assessment.Keywords, riskKeyword.Phrase)
+		}
+	}
+	assessment.Flagged = assessment.Score >= threshold
+	assessment.Severity = severity(assessment.Score, threshold)
+
+	return &assessment, nil
+}
+
+// severity grades a score against the flag threshold. Twice the threshold is
+// treated as critical.
+func severity(score, threshold int) string {
+	switch {
+	case score >= 2*threshold:
+		return risk.SeverityCritical
+	case score >= threshold:
+		return risk.SeverityHigh
+	default:
+		return risk.SeverityLow
+	}
+}
+
+// normalize lower-cases text and collapses runs of whitespace and hyphens so
+// "Self-Harm" and "self   harm" match the same phrase.
+func normalize(value string) string {
+	value = strings.ToLower(value)
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '-'
+	})
+
+	return strings.Join(fields, " ")
+}
+
+// containsWord reports whether phrase occurs in text on word boundaries, so
+// "kill" does not match inside "skill".
+func containsWord(text, phrase string) bool {
+	for offset := 0; offset < len(text); {
+		index := strings.Index(text[offset:], phrase)
+		if index < 0 {
+			return false
+		}
+
+		start := offset + index
+		end := start + len(phrase)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (start == 0 || !isWordRune(before)) && (end == len(text) || !isWordRune(after)) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
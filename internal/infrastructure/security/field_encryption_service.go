@@ -0,0 +1,169 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mucb_be/internal/config"
+	"strings"
+)
+
+// fieldPrefix marks a stored value as encrypted. The full format is
+// enc:<keyId>:<base64(nonce|ciphertext)>, so values written with an older key
+// can still be decrypted after the active key changes.
+const fieldPrefix = "enc:"
+
+const defaultFieldKeyId = "default"
+
+var ErrUnknownFieldKey = errors.New("unknown field encryption key")
+
+type FieldEncryptionServiceInterface interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(value string) (string, error)
+	// NeedsEncryption reports whether value is plaintext or was encrypted with
+	// a key other than the active one.
+	NeedsEncryption(value string) bool
+	BlindIndex(value string) string
+}
+
+type FieldEncryptionService struct {
+	keys          map[string][]byte
+	activeKeyId   string
+	blindIndexKey []byte
+}
+
+// NewFieldEncryptionService reads FIELD_ENCRYPTION_KEYS as comma separated
+// keyId:32-byte-key pairs. Without it the service falls back to
+// ENCRYPTION_DATA_32_BYTES_KEY under the "default" key id.
+func NewFieldEncryptionService(cfg *config.Config) (FieldEncryptionServiceInterface, error) {
+	keys := make(map[string][]byte)
+	activeKeyId := cfg.FieldEncryptionKeyId
+
+	if cfg.FieldEncryptionKeys == "" {
+		keys[defaultFieldKeyId] = []byte(cfg.EncryptionKey)
+		if activeKeyId == "" {
+			activeKeyId = defaultFieldKeyId
+		}
+	}
+
+	for _, pair := range strings.Split(cfg.FieldEncryptionKeys, ",") {
+		if pair == "" {
+			continue
+		}
+
+		keyId, key, found := strings.Cut(pair, ":")
+		if !found || keyId == "" || strings.Contains(keyId, ":") {
+			return nil, fmt.Errorf("invalid field encryption key %q", keyId)
+		}
+		keys[keyId] = []byte(key)
+		if activeKeyId == "" {
+			activeKeyId = keyId
+		}
+	}
+
+	for keyId, key := range keys {
+		if len(key) != 32 {
+			return nil, fmt.Errorf("field encryption key %q must be 32 bytes", keyId)
+		}
+	}
+
+	if _, ok := keys[activeKeyId]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFieldKey, activeKeyId)
+	}
+
+	// ✅ The blind index key must never change, otherwise lookups stop matching
+	blindIndexKey := []byte(cfg.BlindIndexKey)
+	if len(blindIndexKey) == 0 {
+		mac := hmac.New(sha256.New, []byte(cfg.EncryptionKey))
+		mac.Write([]byte("blind-index"))
+		blindIndexKey = mac.Sum(nil)
+	}
+
+	return &FieldEncryptionService{
+		keys:          keys,
+		activeKeyId:   activeKeyId,
+		blindIndexKey: blindIndexKey,
+	}, nil
+}
+
+func (s *FieldEncryptionService) Encrypt(plaintext string) (string, error) {
+	aead, err := newFieldCipher(s.keys[s.activeKeyId])
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	// ✅ The key id is authenticated so it cannot be swapped without detection
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(s.activeKeyId))
+
+	return fieldPrefix + s.activeKeyId + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns plaintext values unchanged so documents written before
+// encryption was enabled stay readable until they are migrated.
+func (s *FieldEncryptionService) Decrypt(value string) (string, error) {
+	if !strings.HasPrefix(value, fieldPrefix) {
+		return value, nil
+	}
+
+	keyId, payload, found := strings.Cut(strings.TrimPrefix(value, fieldPrefix), ":")
+	if !found {
+		return "", fmt.Errorf("malformed encrypted field")
+	}
+
+	key, ok := s.keys[keyId]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownFieldKey, keyId)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := newFieldCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("ciphertext too short")
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(keyId))
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+func (s *FieldEncryptionService) NeedsEncryption(value string) bool {
+	return !strings.HasPrefix(value, fieldPrefix+s.activeKeyId+":")
+}
+
+func (s *FieldEncryptionService) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, s.blindIndexKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newFieldCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const defaultGridFSBucket = "images"
+
+type GridFSObjectStorage struct {
+	bucket *gridfs.Bucket
+}
+
+func NewGridFSObjectStorage(db *mongo.Database, name string) (ObjectStorageInterface, error) {
+	if name == "" {
+		name = defaultGridFSBucket
+	}
+
+	bucket, err := gridfs.NewBucket(db, options.GridFSBucket().SetName(name))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GridFSObjectStorage{
+		bucket: bucket,
+	}, nil
+}
+
+type gridFSFile struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	Length     int64              `bson:"length"`
+	UploadDate time.Time          `bson:"uploadDate"`
+}
+
+// find returns the files stored under key, newest first. GridFS allows
+// several files with one name, so Put removes the older ones afterwards.
+func (s *GridFSObjectStorage) find(key string) ([]gridFSFile, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.GridFSFind().SetSort(bson.D{{Key: "uploadDate", Value: -1}})
+	cursor, err := s.bucket.FindContext(ctx, bson.M{"filename": key}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	files := make([]gridFSFile, 0)
+	if err := cursor.All(ctx, &files); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func (s *GridFSObjectStorage) Put(key string, body io.Reader, contentType string) error {
+	opts := options.GridFSUpload().SetMetadata(bson.M{"contentType": contentType})
+	id, err := s.bucket.UploadFromStream(key, body, opts)
+	if err != nil {
+		return err
+	}
+
+	files, err := s.find(key)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file.ID != id {
+			if err := s.bucket.Delete(file.ID); err != nil && err != gridfs.ErrFileNotFound {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *GridFSObjectStorage) Get(key string) (io.ReadCloser, *ObjectInfo, error) {
+	files, err := s.find(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(files) == 0 {
+		return nil, nil, ErrObjectNotFound
+	}
+
+	stream, err := s.bucket.OpenDownloadStream(files[0].ID)
+	if err == gridfs.ErrFileNotFound {
+		return nil, nil, ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stream, &ObjectInfo{
+		Size:       files[0].Length,
+		ModifiedAt: files[0].UploadDate,
+	}, nil
+}
+
+func (s *GridFSObjectStorage) Delete(key string) error {
+	files, err := s.find(key)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := s.bucket.Delete(file.ID); err != nil && err != gridfs.ErrFileNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
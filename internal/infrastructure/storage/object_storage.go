@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mucb_be/internal/config"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	BackendLocal  = "local"
+	BackendS3     = "s3"
+	BackendGridFS = "gridfs"
+)
+
+var ErrObjectNotFound = errors.New("object not found")
+
+type ObjectInfo struct {
+	Size       int64
+	ModifiedAt time.Time
+}
+
+// ObjectStorageInterface keeps uploaded files outside the API process so
+// every replica serves the same objects. Keys are flat file names.
+type ObjectStorageInterface interface {
+	Put(key string, body io.Reader, contentType string) error
+	Get(key string) (io.ReadCloser, *ObjectInfo, error)
+	Delete(key string) error
+}
+
+// NewObjectStorage creates the backend with the given name. An empty name
+// selects the local filesystem, which is what uploads always used.
+func NewObjectStorage(backend string, cfg *config.Config, db *mongo.Database) (ObjectStorageInterface, error) {
+	switch backend {
+	case "", BackendLocal:
+		return NewLocalObjectStorage(cfg.StorageLocalRoot)
+	case BackendS3:
+		return NewS3ObjectStorage(cfg)
+	case BackendGridFS:
+		return NewGridFSObjectStorage(db, cfg.StorageGridFSBucket)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", backend)
+	}
+}
+
+type readSeekCloser struct {
+	io.ReadSeeker
+	io.Closer
+}
+
+// Seekable returns body as a ReadSeekCloser so range requests can be served
+// from any backend. Local files already seek; other bodies are read into
+// memory, which suits the size of the images stored here.
+func Seekable(body io.ReadCloser) (io.ReadSeekCloser, error) {
+	if seeker, ok := body.(io.ReadSeekCloser); ok {
+		return seeker, nil
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return readSeekCloser{
+		ReadSeeker: bytes.NewReader(data),
+		Closer:     io.NopCloser(nil),
+	}, nil
+}
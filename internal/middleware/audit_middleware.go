@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mucb_be/internal/domain/audit"
+	"mucb_be/internal/infrastructure/auditing"
+	"mucb_be/internal/utils"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditMiddleware records a successful admin mutation in the audit log. The
+// target id is read from the JSON body field or route param named
+// targetField, or for creates from the id the handler stored with
+// utils.SetAuditTarget. Without a loadable target, the request body stands
+// in for the new state. It must run after PermissionMiddleware.
+func AuditMiddleware(auditService auditing.AuditServiceInterface, action, entity, targetField string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var payload map[string]interface{}
+		if strings.HasPrefix(c.ContentType(), "application/json") {
+			body, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"code":    "MWE006001001",
+					"message": "Failed to read request body.",
+				})
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+			_ = json.Unmarshal(body, &payload)
+		}
+
+		target := c.Param(targetField)
+		if value, ok := payload[targetField].(string); ok && targetField != "" {
+			target = value
+		}
+
+		before := auditService.Snapshot(entity, target)
+
+		c.Next()
+
+		// ✅ Only completed changes are audited
+		if len(c.Errors) > 0 || c.Writer.Status() >= http.StatusBadRequest {
+			return
+		}
+
+		if created, ok := c.Get("auditTarget"); ok && target == "" {
+			target, _ = created.(string)
+		}
+
+		claims, err := utils.GetUserClaims(c)
+		if err != nil {
+			return
+		}
+		actor, err := primitive.ObjectIDFromHex(claims.ID)
+		if err != nil {
+			return
+		}
+
+		var after map[string]interface{}
+		if action != audit.ActionRemove {
+			after = auditService.Snapshot(entity, target)
+			if after == nil {
+				after = sanitizePayload(payload)
+			}
+		}
+
+		auditService.Record(audit.NewAuditLog(
+			actor,
+			claims.Role,
+			action,
+			entity,
+			target,
+			before,
+			after,
+			c.ClientIP(),
+			c.Request.UserAgent(),
+		))
+	}
+}
+
+func sanitizePayload(payload map[string]interface{}) map[string]interface{} {
+	if payload == nil {
+		return nil
+	}
+
+	sanitized := make(map[string]interface{}, len(payload))
+	for key, value := range payload {
+		if strings.Contains(strings.ToLower(key), "password") {
+			continue
+		}
+		sanitized[key] = value
+	}
+
+	return sanitized
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"errors"
+	"mucb_be/internal/infrastructure/security"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// AuthMiddleware validates JWT and lets any authenticated caller through
+func AuthMiddleware(jwtService security.JwtServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := authenticate(c, jwtService); !ok {
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// authenticate validates the JWT, stores the claims in the context and aborts
+// the request when the token is missing or invalid.
+func authenticate(c *gin.Context, jwtService security.JwtServiceInterface) (*security.AccessTokenModel, bool) {
+	// ✅ Extract JWT token from Authorization header
+	authHeader := c.GetHeader("X-Authorization")
+
+	if authHeader == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"code":    "MWE001001001",
+			"message": "Missing authorization header.",
+		})
+		return nil, false
+	}
+
+	// ✅ Ensure token format is "Bearer <token>"
+	tokenParts := strings.Split(authHeader, " ")
+	if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"code":    "MWE001001002",
+			"message": "Invalid authorization header format.",
+		})
+		return nil, false
+	}
+	token := tokenParts[1]
+
+	// ✅ Decode JWT token
+	claims, err := jwtService.ValidateAccessToken(token)
+	if err != nil {
+		var statusCode int
+		var code string
+		var message string = "Invalid token."
+
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			err = security.ErrJWTTokenExpired
+		}
+
+		switch {
+		case errors.Is(err, security.ErrJWTTokenExpired):
+			statusCode = http.StatusUnauthorized
+			code = "MWE001001003"
+			message = "Token has expired"
+		case errors.Is(err, security.ErrJWTMissingIDClaim), errors.Is(err, security.ErrJWTMissingRoleClaim):
+			statusCode = http.StatusUnauthorized
+			code = "MWE001001004"
+		default:
+			statusCode = http.StatusUnauthorized
+			code = "MWE001001005"
+		}
+
+		c.AbortWithStatusJSON(statusCode, gin.H{
+			"code":    code,
+			"message": message,
+		})
+		return nil, false
+	}
+
+	// ✅ Store claims in context
+	c.Set("user", claims)
+	return &claims, true
+}
@@ -9,7 +9,7 @@ func CorsMiddleware(allowOrigin string) gin.HandlerFunc {
 	return cors.New(cors.Config{
 		AllowOrigins:  []string{allowOrigin},
 		AllowMethods:  []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "HEAD"},
-		AllowHeaders:  []string{"Content-Type", "X-Authorization", "X-API-KEY"},
-		ExposeHeaders: []string{"Content-Length"},
+		AllowHeaders:  []string{"Content-Type", "X-Authorization", "X-API-KEY", "Idempotency-Key"},
+		ExposeHeaders: []string{"Content-Length", "Idempotency-Replayed"},
 	})
 }
@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"mucb_be/internal/domain/idempotency"
+	"mucb_be/internal/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const maxIdempotencyKeyLength = 128
+
+type idempotencyResponseWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *idempotencyResponseWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *idempotencyResponseWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// IdempotencyMiddleware replays the stored response when a user retries a
+// request with an Idempotency-Key header (or "idempotencyKey" body field) that
+// already completed. It must run after PermissionMiddleware.
+func IdempotencyMiddleware(idempotencyRepo idempotency.IdempotencyKeyRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"code":    "MWE005001001",
+				"message": "Failed to read request body.",
+			})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		key := c.GetHeader("Idempotency-Key")
+		if key == "" {
+			var payload struct {
+				IdempotencyKey string `json:"idempotencyKey"`
+			}
+			_ = json.Unmarshal(body, &payload)
+			key = payload.IdempotencyKey
+		}
+
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+				"code":    "MWE005001002",
+				"message": "Idempotency key is too long.",
+			})
+			return
+		}
+
+		claims, err := utils.GetUserClaims(c)
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+
+		userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"code":    "MWE005001003",
+				"message": "Failed to check user.",
+			})
+			return
+		}
+
+		hash := sha256.Sum256(append([]byte(c.FullPath()+"\n"), body...))
+		requestHash := hex.EncodeToString(hash[:])
+
+		newKey := idempotency.NewIdempotencyKey(userObjectId, key, c.FullPath(), requestHash)
+		err = idempotencyRepo.CreateIdempotencyKey(newKey)
+		if errors.Is(err, idempotency.ErrIdempotencyKeyExists) {
+			replayIdempotentResponse(c, idempotencyRepo, userObjectId, key, requestHash)
+			return
+		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"code":    "MWE005001004",
+				"message": "Failed to store idempotency key.",
+			})
+			return
+		}
+
+		writer := &idempotencyResponseWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = writer
+
+		c.Next()
+
+		// ✅ Only successful results are kept; failures are re-evaluated on retry
+		if len(c.Errors) > 0 || writer.Status() >= http.StatusBadRequest {
+			_ = idempotencyRepo.RemoveIdempotencyKeyById(newKey.ID.Hex())
+			return
+		}
+
+		_ = idempotencyRepo.CompleteIdempotencyKeyById(
+			newKey.ID.Hex(),
+			writer.Status(),
+			writer.body.String(),
+			writer.Header().Get("Content-Type"),
+		)
+	}
+}
+
+func replayIdempotentResponse(
+	c *gin.Context,
+	idempotencyRepo idempotency.IdempotencyKeyRepository,
+	user primitive.ObjectID,
+	key, requestHash string,
+) {
+	existKey, err := idempotencyRepo.FindIdempotencyKey(user, key)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+			"code":    "MWE005002001",
+			"message": "Failed to check idempotency key.",
+		})
+		return
+	}
+
+	if existKey.RequestHash != requestHash {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{
+			"code":    "MWE005002002",
+			"message": "Idempotency key was already used for a different request.",
+		})
+		return
+	}
+
+	if existKey.Status != idempotency.StatusCompleted {
+		c.AbortWithStatusJSON(http.StatusConflict, gin.H{
+			"code":    "MWE005002003",
+			"message": "A request with this idempotency key is still being processed.",
+		})
+		return
+	}
+
+	c.Header("Idempotency-Replayed", "true")
+	if existKey.ResponseBody == "" {
+		c.AbortWithStatus(existKey.ResponseStatus)
+		return
+	}
+
+	c.Data(existKey.ResponseStatus, existKey.ContentType, []byte(existKey.ResponseBody))
+	c.Abort()
+}
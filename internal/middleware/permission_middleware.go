@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"mucb_be/internal/infrastructure/authorization"
+	"mucb_be/internal/infrastructure/security"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PermissionMiddleware validates JWT and checks that the caller's role holds
+// the given permission
+func PermissionMiddleware(
+	jwtService security.JwtServiceInterface,
+	authorizationService authorization.AuthorizationServiceInterface,
+	permission string,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := authenticate(c, jwtService)
+		if !ok {
+			return
+		}
+
+		// ✅ Check the role holds the permission
+		if !authorizationService.HasPermission(claims.Role, permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"code":    "MWE001001006",
+				"message": "Insufficient permissions.",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -8,9 +8,14 @@ type CreateAdminRequest struct {
 	Name     string `json:"name" binding:"required,max=64"`
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required,min=8,max=16"`
-	Role     string `json:"role" binding:"required,oneof=SUPER_ADMIN ADMIN"`
+	Role     string `json:"role" binding:"required,oneof=SUPER_ADMIN ADMIN COUNSELLOR"`
 }
 
 func (req *CreateAdminRequest) Validate() error {
 	return validate.Struct(req)
 }
+
+type UpdateRiskAlertRequest struct {
+	Admin            string `json:"admin" binding:"required"`
+	ReceiveRiskAlert *bool  `json:"receiveRiskAlert" binding:"required"`
+}
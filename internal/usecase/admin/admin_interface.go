@@ -1,5 +1,6 @@
 package admin
 
 type AdminUseCase interface {
-	CreateAdmin(req *CreateAdminRequest) error
+	CreateAdmin(req *CreateAdminRequest) (string, error)
+	UpdateRiskAlert(req *UpdateRiskAlertRequest) error
 }
@@ -23,10 +23,10 @@ func NewAdminUseCase(
 	}
 }
 
-func (u *AdminUseCaseImpl) CreateAdmin(req *CreateAdminRequest) error {
+func (u *AdminUseCaseImpl) CreateAdmin(req *CreateAdminRequest) (string, error) {
 	existingAdmin, _ := u.adminRepo.FindAdminByEmail(strings.ToLower(req.Email))
 	if existingAdmin != nil {
-		return errors.NewCustomError(
+		return "", errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE001001001",
 			"Email already exist.",
@@ -36,7 +36,7 @@ func (u *AdminUseCaseImpl) CreateAdmin(req *CreateAdminRequest) error {
 
 	hashedPassword, err := u.hashService.HashPassword(req.Password)
 	if err != nil {
-		return errors.NewCustomError(
+		return "", errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE001001002",
 			"Can not create admin.",
@@ -48,7 +48,7 @@ func (u *AdminUseCaseImpl) CreateAdmin(req *CreateAdminRequest) error {
 
 	err = u.adminRepo.CreateAdmin(newAdmin)
 	if err != nil {
-		return errors.NewCustomError(
+		return "", errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE001001003",
 			"Can not create admin.",
@@ -56,5 +56,19 @@ func (u *AdminUseCaseImpl) CreateAdmin(req *CreateAdminRequest) error {
 		)
 	}
 
+	return newAdmin.ID.Hex(), nil
+}
+
+func (u *AdminUseCaseImpl) UpdateRiskAlert(req *UpdateRiskAlertRequest) error {
+	err := u.adminRepo.UpdateRiskAlertById(req.Admin, *req.ReceiveRiskAlert)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE001002001",
+			"Can not update admin.",
+			err.Error(),
+		)
+	}
+
 	return nil
 }
@@ -0,0 +1,19 @@
+package audit
+
+import "mucb_be/internal/domain/audit"
+
+type GetAuditLogsRequest struct {
+	Page   int
+	Limit  int
+	Actor  *string
+	Entity *string
+	Target *string
+	From   *string
+	To     *string
+}
+
+type GetAuditLogsOutput struct {
+	Total int               `json:"total"`
+	Page  int               `json:"page"`
+	Items *[]audit.AuditLog `json:"items"`
+}
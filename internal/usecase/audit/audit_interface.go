@@ -0,0 +1,5 @@
+package audit
+
+type AuditInterface interface {
+	FindAuditLogs(req *GetAuditLogsRequest) (*GetAuditLogsOutput, error)
+}
@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"mucb_be/internal/domain/audit"
+	"mucb_be/internal/errors"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const auditDateLayout = "2006-01-02"
+
+type AuditUseCaseImpl struct {
+	auditLogRepo audit.AuditLogRepository
+}
+
+func NewAuditUseCase(auditLogRepo audit.AuditLogRepository) AuditInterface {
+	return &AuditUseCaseImpl{
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+func (u *AuditUseCaseImpl) FindAuditLogs(req *GetAuditLogsRequest) (*GetAuditLogsOutput, error) {
+	filter := audit.AuditLogFilter{
+		Entity: req.Entity,
+		Target: req.Target,
+	}
+
+	if req.Actor != nil {
+		actorObjectId, err := primitive.ObjectIDFromHex(*req.Actor)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE012001001",
+				"Invalid actor.",
+				err.Error(),
+			)
+		}
+		filter.Actor = &actorObjectId
+	}
+
+	// ✅ Dates are whole UTC days; "to" includes the whole day
+	if req.From != nil {
+		from, err := time.Parse(auditDateLayout, *req.From)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE012001002",
+				"Invalid from date.",
+				err.Error(),
+			)
+		}
+		filter.From = &from
+	}
+
+	if req.To != nil {
+		to, err := time.Parse(auditDateLayout, *req.To)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE012001003",
+				"Invalid to date.",
+				err.Error(),
+			)
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	auditLogs, total, err := u.auditLogRepo.FindAuditLogs(filter, req.Page, req.Limit)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE012001004",
+			"Failed to find audit logs.",
+			err.Error(),
+		)
+	}
+
+	return &GetAuditLogsOutput{
+		Total: total,
+		Page:  req.Page,
+		Items: auditLogs,
+	}, nil
+}
@@ -1,11 +1,20 @@
 package card
 
-import "mucb_be/internal/domain/card"
+import (
+	"mucb_be/internal/domain/card"
+	"mucb_be/internal/domain/record"
+	"mucb_be/internal/domain/schedule"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
 
 type CreateCardRequest struct {
-	Name        string `json:"name" binding:"required"`
-	Description string `json:"description" binding:"required,max=2048"`
-	Image       string `json:"image" binding:"required"`
+	Name         string                   `json:"name" binding:"required"`
+	Description  string                   `json:"description" binding:"required,max=2048"`
+	Image        string                   `json:"image" binding:"required"`
+	Category     string                   `json:"category,omitempty" binding:"omitempty,max=32"`
+	Tags         []string                 `json:"tags,omitempty" binding:"omitempty,max=10,dive,required,max=32"`
+	Translations map[string]card.CardText `json:"translations,omitempty" binding:"omitempty,dive"`
 }
 
 type FindCardOutput struct {
@@ -13,8 +22,12 @@ type FindCardOutput struct {
 }
 
 type GetCardsRequest struct {
-	Page  int `json:"page" binding:"required,min=1"`
-	Limit int `json:"limit" binding:"required,min=1,max=50"`
+	Page           int    `json:"page" binding:"required,min=1"`
+	Limit          int    `json:"limit" binding:"required,min=1,max=50"`
+	Category       string `json:"category"`
+	Tag            string `json:"tag"`
+	Archived       bool   `json:"archived"`
+	AcceptLanguage string `json:"-"`
 }
 
 type GetCardsOutput struct {
@@ -27,9 +40,85 @@ type ActivateCard struct {
 	Card string `json:"card" binding:"required"`
 }
 
+type DeactivateCard struct {
+	Card string `json:"card" binding:"required"`
+}
+
+type ReorderCardsRequest struct {
+	Cards []string `json:"cards" binding:"required,min=1,max=200,dive,len=24"`
+}
+
 type UpdateCardRequest struct {
-	Card        string `json:"card" binding:"required"`
-	Name        string `json:"name" binding:"required"`
-	Description string `json:"description" binding:"required,max=2048"`
-	Image       string `json:"image" binding:"required"`
+	Card         string                   `json:"card" binding:"required"`
+	Name         string                   `json:"name" binding:"required"`
+	Description  string                   `json:"description" binding:"required,max=2048"`
+	Image        string                   `json:"image" binding:"required"`
+	Category     string                   `json:"category,omitempty" binding:"omitempty,max=32"`
+	Tags         []string                 `json:"tags,omitempty" binding:"omitempty,max=10,dive,required,max=32"`
+	Translations map[string]card.CardText `json:"translations,omitempty" binding:"omitempty,dive"`
+}
+
+type ArchiveCardRequest struct {
+	Card     string `json:"card" binding:"required,len=24"`
+	Archived bool   `json:"archived"`
+}
+
+type RemoveCardRequest struct {
+	Card string `json:"card" binding:"required,len=24"`
+}
+
+type CheckAvailableCardOutput struct {
+	schedule.Availability
+}
+
+type UpdateCardScheduleRequest struct {
+	Schedule schedule.Schedule `json:"schedule" binding:"required"`
+}
+
+type CreateCardCategoryRequest struct {
+	Code         string                           `json:"code" binding:"required,max=32"`
+	Name         string                           `json:"name" binding:"required,max=64"`
+	Limit        int                              `json:"limit" binding:"required,min=1,max=20"`
+	Translations map[string]card.CardCategoryText `json:"translations,omitempty" binding:"omitempty,dive"`
+}
+
+type GetCardCategoriesRequest struct {
+	AcceptLanguage string
+}
+
+type GetCardCategoriesOutput struct {
+	DefaultLimit int                  `json:"defaultLimit"`
+	Items        *[]card.CardCategory `json:"items"`
+}
+
+type UpdateCardCategoryRequest struct {
+	Category     string                           `json:"category" binding:"required,len=24"`
+	Name         string                           `json:"name" binding:"required,max=64"`
+	Limit        int                              `json:"limit" binding:"required,min=1,max=20"`
+	Translations map[string]card.CardCategoryText `json:"translations,omitempty" binding:"omitempty,dive"`
+}
+
+type GetCardStatisticsRequest struct {
+	From      *string
+	To        *string
+	Interval  string
+	Timezone  string
+	GroupCode *string
+}
+
+type CardPickTotal struct {
+	Card     primitive.ObjectID `json:"card"`
+	Name     string             `json:"name"`
+	Category string             `json:"category,omitempty"`
+	Count    int                `json:"count"`
+}
+
+type GetCardStatisticsOutput struct {
+	From     string                 `json:"from"`
+	To       string                 `json:"to"`
+	Interval string                 `json:"interval"`
+	Timezone string                 `json:"timezone"`
+	Totals   []CardPickTotal        `json:"totals"`
+	Periods  []record.CardPickCount `json:"periods"`
+	Pairs    []record.CardPairCount `json:"pairs"`
 }
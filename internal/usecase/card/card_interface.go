@@ -1,12 +1,25 @@
 package card
 
-import "mucb_be/internal/infrastructure/security"
+import (
+	"mucb_be/internal/domain/schedule"
+	"mucb_be/internal/infrastructure/security"
+)
 
 type CardInterface interface {
-	CreateCard(req *CreateCardRequest) error
+	CreateCard(req *CreateCardRequest) (string, error)
 	FindCardById(id string) (*FindCardOutput, error)
 	FindAllCard(req *GetCardsRequest, claims *security.AccessTokenModel) (*GetCardsOutput, error)
 	FindCardByIdAndActivate(req *ActivateCard) error
+	FindCardByIdAndDeactivate(req *DeactivateCard) error
+	ReorderCards(req *ReorderCardsRequest) error
 	UpdateCardById(req *UpdateCardRequest) error
-	CheckAvailableCard(claims *security.AccessTokenModel) error
+	ArchiveCard(req *ArchiveCardRequest) error
+	RemoveCard(req *RemoveCardRequest) error
+	CheckAvailableCard(claims *security.AccessTokenModel) (*CheckAvailableCardOutput, error)
+	FindCardSchedule() (*schedule.Schedule, error)
+	UpdateCardSchedule(req *UpdateCardScheduleRequest) error
+	CreateCardCategory(req *CreateCardCategoryRequest) (string, error)
+	FindAllCardCategories(req *GetCardCategoriesRequest, claims *security.AccessTokenModel) (*GetCardCategoriesOutput, error)
+	UpdateCardCategory(req *UpdateCardCategoryRequest) error
+	FindCardStatistics(req *GetCardStatisticsRequest) (*GetCardStatisticsOutput, error)
 }
@@ -1,41 +1,79 @@
 package card
 
 import (
+	goerrors "errors"
 	"mucb_be/internal/domain/card"
 	"mucb_be/internal/domain/image"
+	"mucb_be/internal/domain/locale"
+	"mucb_be/internal/domain/permission"
 	"mucb_be/internal/domain/record"
+	"mucb_be/internal/domain/schedule"
 	"mucb_be/internal/domain/user"
 	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/authorization"
+	"mucb_be/internal/infrastructure/clock"
+	"mucb_be/internal/infrastructure/scheduling"
 	"mucb_be/internal/infrastructure/security"
 
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	statisticsDateLayout  = "2006-01-02"
+	defaultStatisticsDays = 30
+	maxStatisticsDays     = 366
+	cardPairLimit         = 20
+)
+
 type CardUserCaseImpl struct {
-	cardRepo       card.CardRepository
-	imageRepo      image.ImageRepository
-	cardRecordRepo record.CardRecordRepository
+	cardRepo             card.CardRepository
+	cardRecordRepo       record.CardRecordRepository
+	cardCategoryRepo     card.CardCategoryRepository
+	imageRepo            image.ImageRepository
+	activityScheduleRepo schedule.ActivityScheduleRepository
+	scheduleService      scheduling.ScheduleServiceInterface
+	availabilityService  scheduling.AvailabilityServiceInterface
+	userRepo             user.UserRepository
+	clock                clock.ClockInterface
+	authorizationService authorization.AuthorizationServiceInterface
 }
 
 func NewCardUseCase(
 	cardRepo card.CardRepository,
-	imageRepo image.ImageRepository,
 	cardRecordRepo record.CardRecordRepository,
+	cardCategoryRepo card.CardCategoryRepository,
+	imageRepo image.ImageRepository,
+	activityScheduleRepo schedule.ActivityScheduleRepository,
+	scheduleService scheduling.ScheduleServiceInterface,
+	availabilityService scheduling.AvailabilityServiceInterface,
+	userRepo user.UserRepository,
+	clock clock.ClockInterface,
+	authorizationService authorization.AuthorizationServiceInterface,
 ) CardInterface {
 	return &CardUserCaseImpl{
-		cardRepo:       cardRepo,
-		imageRepo:      imageRepo,
-		cardRecordRepo: cardRecordRepo,
+		cardRepo:             cardRepo,
+		cardRecordRepo:       cardRecordRepo,
+		cardCategoryRepo:     cardCategoryRepo,
+		imageRepo:            imageRepo,
+		activityScheduleRepo: activityScheduleRepo,
+		scheduleService:      scheduleService,
+		availabilityService:  availabilityService,
+		userRepo:             userRepo,
+		clock:                clock,
+		authorizationService: authorizationService,
 	}
 }
 
-func (u *CardUserCaseImpl) CreateCard(req *CreateCardRequest) error {
+func (u *CardUserCaseImpl) CreateCard(req *CreateCardRequest) (string, error) {
 
 	imageObjectID, err := primitive.ObjectIDFromHex(req.Image)
 	if err != nil {
-		return errors.NewCustomError(
+		return "", errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE007001001",
 			"Failed to convert image.",
@@ -43,10 +81,31 @@ func (u *CardUserCaseImpl) CreateCard(req *CreateCardRequest) error {
 		)
 	}
 
-	newCard := card.NewCard(req.Name, req.Description, imageObjectID)
+	err = u.checkImage(req.Image, "UCE007001004")
+	if err != nil {
+		return "", err
+	}
+
+	req.Category = normalizeCategoryCode(req.Category)
+	err = u.checkCategory(req.Category, "UCE007001005")
+	if err != nil {
+		return "", err
+	}
+
+	err = locale.Validate(req.Translations)
+	if err != nil {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007001003",
+			"Invalid translations.",
+			err.Error(),
+		)
+	}
+
+	newCard := card.NewCard(req.Name, req.Description, imageObjectID, req.Category, req.Tags, req.Translations)
 	err = u.cardRepo.CreateCard(newCard)
 	if err != nil {
-		return errors.NewCustomError(
+		return "", errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE007001002",
 			"Failed to insert card.",
@@ -54,7 +113,7 @@ func (u *CardUserCaseImpl) CreateCard(req *CreateCardRequest) error {
 		)
 	}
 
-	return nil
+	return newCard.ID.Hex(), nil
 }
 
 func (u *CardUserCaseImpl) FindCardById(id string) (*FindCardOutput, error) {
@@ -76,19 +135,31 @@ func (u *CardUserCaseImpl) FindCardById(id string) (*FindCardOutput, error) {
 }
 
 func (u *CardUserCaseImpl) FindAllCard(req *GetCardsRequest, claims *security.AccessTokenModel) (*GetCardsOutput, error) {
-	var isAdmin bool = true
-	if claims.Role == user.RoleUser {
-		isAdmin = false
-	}
+	isAdmin := u.authorizationService.HasPermission(claims.Role, permission.CardManage)
 
 	if !isAdmin {
-		err := u.CheckAvailableCard(claims)
+		availability, err := u.CheckAvailableCard(claims)
 		if err != nil {
 			return nil, err
 		}
+
+		if !availability.Available {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE007003001",
+				"Cards are not available right now.",
+				"",
+			)
+		}
+	}
+
+	filter := card.CardFilter{
+		Category: normalizeCategoryCode(req.Category),
+		Tag:      req.Tag,
+		Archived: isAdmin && req.Archived,
 	}
 
-	groups, total, err := u.cardRepo.FindAllCardByRole(req.Page, req.Limit, isAdmin)
+	groups, total, err := u.cardRepo.FindAllCardByRole(req.Page, req.Limit, isAdmin, filter)
 	if err != nil {
 		return nil, errors.NewCustomError(
 			http.StatusForbidden,
@@ -98,6 +169,18 @@ func (u *CardUserCaseImpl) FindAllCard(req *GetCardsRequest, claims *security.Ac
 		)
 	}
 
+	// ✅ Admins manage every translation, participants get their own locale
+	if !isAdmin {
+		name := locale.Resolve(req.AcceptLanguage, "")
+		existUser, err := u.userRepo.FindUserById(claims.ID)
+		if err == nil {
+			name = locale.Resolve(req.AcceptLanguage, existUser.Language)
+		}
+		for i := range *groups {
+			(*groups)[i].Localize(name)
+		}
+	}
+
 	return &GetCardsOutput{
 		Total: total,
 		Page:  req.Page,
@@ -119,6 +202,61 @@ func (u *CardUserCaseImpl) FindCardByIdAndActivate(req *ActivateCard) error {
 	return nil
 }
 
+func (u *CardUserCaseImpl) FindCardByIdAndDeactivate(req *DeactivateCard) error {
+	err := u.cardRepo.FindCardByIdAndDeactivate(req.Card)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusForbidden,
+			"UCE007011001",
+			"Failed to update cards.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+// ReorderCards sets the display order of the given cards to the order of
+// the request.
+func (u *CardUserCaseImpl) ReorderCards(req *ReorderCardsRequest) error {
+	ids := make([]primitive.ObjectID, 0, len(req.Cards))
+	seen := make(map[primitive.ObjectID]bool)
+	for _, id := range req.Cards {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE007012001",
+				"Failed to convert card.",
+				err.Error(),
+			)
+		}
+
+		if seen[objectID] {
+			return errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE007012002",
+				"Card is listed twice.",
+				id,
+			)
+		}
+		seen[objectID] = true
+		ids = append(ids, objectID)
+	}
+
+	err := u.cardRepo.ReorderCards(ids)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007012003",
+			"Failed to reorder cards.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
 func (u *CardUserCaseImpl) UpdateCardById(req *UpdateCardRequest) error {
 	cardExist, err := u.cardRepo.FindCardById(req.Card)
 	if err != nil {
@@ -140,7 +278,28 @@ func (u *CardUserCaseImpl) UpdateCardById(req *UpdateCardRequest) error {
 		)
 	}
 
-	err = u.cardRepo.UpdateCardById(req.Card, req.Name, req.Description, imageObjectID)
+	err = u.checkImage(req.Image, "UCE007005005")
+	if err != nil {
+		return err
+	}
+
+	req.Category = normalizeCategoryCode(req.Category)
+	err = u.checkCategory(req.Category, "UCE007005006")
+	if err != nil {
+		return err
+	}
+
+	err = locale.Validate(req.Translations)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007005004",
+			"Invalid translations.",
+			err.Error(),
+		)
+	}
+
+	err = u.cardRepo.UpdateCardById(req.Card, req.Name, req.Description, imageObjectID, req.Category, req.Tags, req.Translations)
 	if err != nil {
 		return errors.NewCustomError(
 			http.StatusBadRequest,
@@ -156,10 +315,128 @@ func (u *CardUserCaseImpl) UpdateCardById(req *UpdateCardRequest) error {
 	return nil
 }
 
-func (u *CardUserCaseImpl) CheckAvailableCard(claims *security.AccessTokenModel) error {
-	userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
+// checkImage makes sure a card is not pointed at an image that is missing or
+// archived.
+func (u *CardUserCaseImpl) checkImage(id, code string) error {
+	imageExist, err := u.imageRepo.FindImageByID(id)
 	if err != nil {
 		return errors.NewCustomError(
+			http.StatusBadRequest,
+			code,
+			"Image not found.",
+			err.Error(),
+		)
+	}
+
+	if imageExist.ArchivedAt != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			code,
+			"Image is archived.",
+			"",
+		)
+	}
+
+	return nil
+}
+
+// checkCategory makes sure a card is put in a category that exists. Cards
+// may have no category.
+func (u *CardUserCaseImpl) checkCategory(code, errorCode string) error {
+	if code == "" {
+		return nil
+	}
+
+	_, err := u.cardCategoryRepo.FindCardCategoryByCode(code)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			errorCode,
+			"Card category not found.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+// normalizeCategoryCode puts a category code in the form it is stored in.
+func normalizeCategoryCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ArchiveCard hides a card from participants and deactivates it. Card
+// records keep pointing at the archived card.
+func (u *CardUserCaseImpl) ArchiveCard(req *ArchiveCardRequest) error {
+	_, err := u.cardRepo.FindCardById(req.Card)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007009001",
+			"Failed to find card.",
+			err.Error(),
+		)
+	}
+
+	var archivedAt *time.Time
+	if req.Archived {
+		now := u.clock.Now()
+		archivedAt = &now
+	}
+
+	err = u.cardRepo.ArchiveCardById(req.Card, archivedAt)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007009002",
+			"Failed to archive card.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+// RemoveCard deletes a card nobody has picked yet. Cards with records can
+// only be archived.
+func (u *CardUserCaseImpl) RemoveCard(req *RemoveCardRequest) error {
+	cardExist, err := u.cardRepo.FindCardById(req.Card)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007010001",
+			"Failed to find card.",
+			err.Error(),
+		)
+	}
+
+	err = u.cardRepo.RemoveCardById(req.Card)
+	if goerrors.Is(err, card.ErrCardHasRecords) {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007010003",
+			"Card has records, archive it instead.",
+			"",
+		)
+	}
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007010004",
+			"Failed to remove card.",
+			err.Error(),
+		)
+	}
+
+	_ = u.imageRepo.UpdateImageStatusById(cardExist.Image.Hex(), false)
+
+	return nil
+}
+
+func (u *CardUserCaseImpl) CheckAvailableCard(claims *security.AccessTokenModel) (*CheckAvailableCardOutput, error) {
+	userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE007006001",
 			"Failed to check user.",
@@ -167,9 +444,19 @@ func (u *CardUserCaseImpl) CheckAvailableCard(claims *security.AccessTokenModel)
 		)
 	}
 
-	isAlreadySubmit, err := u.cardRecordRepo.HasSubmittedToday(userObjectId)
+	existUser, err := u.userRepo.FindUserById(claims.ID)
 	if err != nil {
-		return errors.NewCustomError(
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007006003",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	availability, err := u.availabilityService.CardAvailability(userObjectId, u.clock.Now(), existUser.Location())
+	if err != nil {
+		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE007006002",
 			"Failed to check submission status.",
@@ -177,14 +464,273 @@ func (u *CardUserCaseImpl) CheckAvailableCard(claims *security.AccessTokenModel)
 		)
 	}
 
-	if isAlreadySubmit {
+	return &CheckAvailableCardOutput{
+		Availability: *availability,
+	}, nil
+}
+
+func (u *CardUserCaseImpl) FindCardSchedule() (*schedule.Schedule, error) {
+	cardSchedule, err := u.availabilityService.CardSchedule()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007007001",
+			"Failed to find card schedule.",
+			err.Error(),
+		)
+	}
+
+	return cardSchedule, nil
+}
+
+func (u *CardUserCaseImpl) UpdateCardSchedule(req *UpdateCardScheduleRequest) error {
+	err := u.scheduleService.Validate(&req.Schedule)
+	if err != nil {
 		return errors.NewCustomError(
 			http.StatusBadRequest,
-			"UCE007006003",
-			"You can only submit once per day",
-			"",
+			"UCE007008001",
+			"Invalid schedule.",
+			err.Error(),
+		)
+	}
+
+	err = u.activityScheduleRepo.UpsertActivitySchedule(schedule.ActivityCard, &req.Schedule)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007008002",
+			"Failed to update card schedule.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+func (u *CardUserCaseImpl) CreateCardCategory(req *CreateCardCategoryRequest) (string, error) {
+	err := locale.Validate(req.Translations)
+	if err != nil {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007013001",
+			"Invalid translations.",
+			err.Error(),
+		)
+	}
+
+	newCardCategory := card.NewCardCategory(normalizeCategoryCode(req.Code), req.Name, req.Limit, req.Translations)
+	err = u.cardCategoryRepo.CreateCardCategory(newCardCategory)
+	if err != nil {
+		if goerrors.Is(err, card.ErrDuplicateCategoryCode) {
+			return "", errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE007013002",
+				"Code duplicated.",
+				err.Error(),
+			)
+		}
+
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007013003",
+			"Failed to insert card category.",
+			err.Error(),
+		)
+	}
+
+	return newCardCategory.ID.Hex(), nil
+}
+
+func (u *CardUserCaseImpl) FindAllCardCategories(req *GetCardCategoriesRequest, claims *security.AccessTokenModel) (*GetCardCategoriesOutput, error) {
+	cardCategories, err := u.cardCategoryRepo.FindAllCardCategories()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007014001",
+			"Failed to find card categories.",
+			err.Error(),
+		)
+	}
+
+	if !u.authorizationService.HasPermission(claims.Role, permission.CardManage) {
+		name := locale.Resolve(req.AcceptLanguage, "")
+		existUser, err := u.userRepo.FindUserById(claims.ID)
+		if err == nil {
+			name = locale.Resolve(req.AcceptLanguage, existUser.Language)
+		}
+		for i := range *cardCategories {
+			(*cardCategories)[i].Localize(name)
+		}
+	}
+
+	return &GetCardCategoriesOutput{
+		DefaultLimit: card.DefaultSelectionLimit,
+		Items:        cardCategories,
+	}, nil
+}
+
+func (u *CardUserCaseImpl) UpdateCardCategory(req *UpdateCardCategoryRequest) error {
+	err := locale.Validate(req.Translations)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007015001",
+			"Invalid translations.",
+			err.Error(),
+		)
+	}
+
+	err = u.cardCategoryRepo.UpdateCardCategoryById(req.Category, req.Name, req.Limit, req.Translations)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007015002",
+			"Failed to update card category.",
+			err.Error(),
 		)
 	}
 
 	return nil
 }
+
+// FindCardStatistics counts how often every card was picked within a range of
+// whole days in the requested timezone, per period and cohort, and which cards
+// are picked together most often. Without a range it covers the last 30 days.
+func (u *CardUserCaseImpl) FindCardStatistics(req *GetCardStatisticsRequest) (*GetCardStatisticsOutput, error) {
+	if _, ok := record.PeriodFormats[req.Interval]; !ok {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007016001",
+			"Interval must be DAY, WEEK or MONTH.",
+			"",
+		)
+	}
+
+	location, err := time.LoadLocation(req.Timezone)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007016002",
+			"Invalid timezone.",
+			err.Error(),
+		)
+	}
+
+	now := u.clock.Now().In(location)
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location).AddDate(0, 0, 1)
+	if req.To != nil {
+		to, err := time.ParseInLocation(statisticsDateLayout, *req.To, location)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE007016003",
+				"Invalid to date.",
+				err.Error(),
+			)
+		}
+		end = to.AddDate(0, 0, 1)
+	}
+
+	start := end.AddDate(0, 0, -defaultStatisticsDays)
+	if req.From != nil {
+		start, err = time.ParseInLocation(statisticsDateLayout, *req.From, location)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE007016004",
+				"Invalid from date.",
+				err.Error(),
+			)
+		}
+	}
+
+	if !start.Before(end) || end.Sub(start) > maxStatisticsDays*24*time.Hour {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007016005",
+			"The range must cover between 1 and 366 days.",
+			"",
+		)
+	}
+
+	filter := record.CardStatisticsFilter{
+		Start:     start,
+		End:       end,
+		Interval:  req.Interval,
+		Timezone:  location.String(),
+		GroupCode: req.GroupCode,
+	}
+
+	periods, err := u.cardRecordRepo.CountCardPicks(filter)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007016006",
+			"Failed to count card picks.",
+			err.Error(),
+		)
+	}
+
+	pairs, err := u.cardRecordRepo.CountCardPairs(filter, cardPairLimit)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007016007",
+			"Failed to count card pairs.",
+			err.Error(),
+		)
+	}
+
+	cardObjectIds := make([]primitive.ObjectID, 0)
+	totalsByCard := make(map[primitive.ObjectID]*CardPickTotal)
+	for _, period := range periods {
+		total, ok := totalsByCard[period.Card]
+		if !ok {
+			total = &CardPickTotal{
+				Card: period.Card,
+				Name: period.CardName,
+			}
+			totalsByCard[period.Card] = total
+			cardObjectIds = append(cardObjectIds, period.Card)
+		}
+		total.Count += period.Count
+	}
+
+	existCards, err := u.cardRepo.FindCardsByIds(cardObjectIds)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE007016008",
+			"Failed to find cards.",
+			err.Error(),
+		)
+	}
+
+	// ✅ Admins see the current card names, removed cards keep their snapshot
+	for _, existCard := range *existCards {
+		total := totalsByCard[existCard.ID]
+		total.Name = existCard.Name
+		total.Category = existCard.Category
+	}
+	for i := range periods {
+		periods[i].CardName = totalsByCard[periods[i].Card].Name
+	}
+
+	totals := make([]CardPickTotal, 0, len(cardObjectIds))
+	for _, cardObjectId := range cardObjectIds {
+		totals = append(totals, *totalsByCard[cardObjectId])
+	}
+	sort.SliceStable(totals, func(i, j int) bool {
+		return totals[i].Count > totals[j].Count
+	})
+
+	return &GetCardStatisticsOutput{
+		From:     start.Format(statisticsDateLayout),
+		To:       end.AddDate(0, 0, -1).Format(statisticsDateLayout),
+		Interval: req.Interval,
+		Timezone: location.String(),
+		Totals:   totals,
+		Periods:  periods,
+		Pairs:    pairs,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package counselling
+
+import (
+	"mucb_be/internal/domain/counselling"
+	"mucb_be/internal/domain/record"
+)
+
+type CreateAssignmentRequest struct {
+	Counsellor string  `json:"counsellor" binding:"required"`
+	User       *string `json:"user,omitempty" binding:"required_without=GroupCode,excluded_with=GroupCode"`
+	GroupCode  *string `json:"groupCode,omitempty" binding:"omitempty,max=64"`
+}
+
+type RemoveAssignmentRequest struct {
+	Assignment string `json:"assignment" binding:"required"`
+}
+
+type GetAssignmentsOutput struct {
+	Items *[]counselling.Assignment `json:"items"`
+}
+
+type GetAccessLogsRequest struct {
+	Counsellor *string
+	Page       int
+	Limit      int
+}
+
+type GetAccessLogsOutput struct {
+	Total int                      `json:"total"`
+	Page  int                      `json:"page"`
+	Items *[]counselling.AccessLog `json:"items"`
+}
+
+type Participant struct {
+	ID                string  `json:"id"`
+	Name              string  `json:"name"`
+	GroupCode         *string `json:"group"`
+	CounsellorConsent bool    `json:"counsellorConsent"`
+}
+
+type GetParticipantsOutput struct {
+	Items []Participant `json:"items"`
+}
+
+type GetParticipantRecordsOutput struct {
+	GroupRecords   *[]record.GroupRecord `json:"groupRecords"`
+	CardRecords    *[]record.CardRecord  `json:"cardRecords"`
+	FlaggedStories *[]record.StoryRecord `json:"flaggedStories"`
+}
+
+type CreateNoteRequest struct {
+	User    string `json:"user" binding:"required"`
+	Content string `json:"content" binding:"required,max=4048"`
+}
+
+type GetNotesOutput struct {
+	Items *[]counselling.CounsellorNote `json:"items"`
+}
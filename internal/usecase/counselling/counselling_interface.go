@@ -0,0 +1,14 @@
+package counselling
+
+import "mucb_be/internal/infrastructure/security"
+
+type CounsellingInterface interface {
+	CreateAssignment(req *CreateAssignmentRequest) (string, error)
+	FindAssignmentsByCounsellor(counsellorId string) (*GetAssignmentsOutput, error)
+	RemoveAssignment(req *RemoveAssignmentRequest) error
+	FindAccessLogs(req *GetAccessLogsRequest) (*GetAccessLogsOutput, error)
+	FindParticipants(claims *security.AccessTokenModel) (*GetParticipantsOutput, error)
+	FindParticipantRecords(userId string, claims *security.AccessTokenModel) (*GetParticipantRecordsOutput, error)
+	CreateNote(req *CreateNoteRequest, claims *security.AccessTokenModel) error
+	FindNotes(userId string, claims *security.AccessTokenModel) (*GetNotesOutput, error)
+}
@@ -0,0 +1,392 @@
+package counselling
+
+import (
+	goerrors "errors"
+	"mucb_be/internal/domain/admin"
+	"mucb_be/internal/domain/counselling"
+	"mucb_be/internal/domain/record"
+	"mucb_be/internal/domain/user"
+	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/security"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const participantRecordLimit = 100
+
+var (
+	errNotAssigned = goerrors.New("participant is not assigned to counsellor")
+	errNoConsent   = goerrors.New("participant has not consented")
+)
+
+type CounsellingUseCaseImpl struct {
+	assignmentRepo     counselling.AssignmentRepository
+	counsellorNoteRepo counselling.CounsellorNoteRepository
+	accessLogRepo      counselling.AccessLogRepository
+	adminRepo          admin.AdminRepository
+	userRepo           user.UserRepository
+	groupRecordRepo    record.GroupRecordRepository
+	cardRecordRepo     record.CardRecordRepository
+	storyRecordRepo    record.StoryRecordRepository
+}
+
+func NewCounsellingUseCase(
+	assignmentRepo counselling.AssignmentRepository,
+	counsellorNoteRepo counselling.CounsellorNoteRepository,
+	accessLogRepo counselling.AccessLogRepository,
+	adminRepo admin.AdminRepository,
+	userRepo user.UserRepository,
+	groupRecordRepo record.GroupRecordRepository,
+	cardRecordRepo record.CardRecordRepository,
+	storyRecordRepo record.StoryRecordRepository,
+) CounsellingInterface {
+	return &CounsellingUseCaseImpl{
+		assignmentRepo:     assignmentRepo,
+		counsellorNoteRepo: counsellorNoteRepo,
+		accessLogRepo:      accessLogRepo,
+		adminRepo:          adminRepo,
+		userRepo:           userRepo,
+		groupRecordRepo:    groupRecordRepo,
+		cardRecordRepo:     cardRecordRepo,
+		storyRecordRepo:    storyRecordRepo,
+	}
+}
+
+func (u *CounsellingUseCaseImpl) CreateAssignment(req *CreateAssignmentRequest) (string, error) {
+	counsellor, err := u.adminRepo.FindAdminById(req.Counsellor)
+	if err != nil || counsellor.Role != admin.RoleCounsellor {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010001001",
+			"Counsellor not found.",
+			"",
+		)
+	}
+
+	var userObjectId *primitive.ObjectID
+	if req.User != nil {
+		existUser, err := u.userRepo.FindUserById(*req.User)
+		if err != nil {
+			return "", errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE010001002",
+				"User not found.",
+				err.Error(),
+			)
+		}
+		userObjectId = &existUser.ID
+	}
+
+	newAssignment := counselling.NewAssignment(counsellor.ID, userObjectId, req.GroupCode)
+	err = u.assignmentRepo.CreateAssignment(newAssignment)
+	if err != nil {
+		if goerrors.Is(err, counselling.ErrDuplicateAssignment) {
+			return "", errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE010001003",
+				"Assignment already exist.",
+				err.Error(),
+			)
+		}
+
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010001004",
+			"Failed to insert assignment.",
+			err.Error(),
+		)
+	}
+
+	return newAssignment.ID.Hex(), nil
+}
+
+func (u *CounsellingUseCaseImpl) FindAssignmentsByCounsellor(counsellorId string) (*GetAssignmentsOutput, error) {
+	counsellorObjectId, err := primitive.ObjectIDFromHex(counsellorId)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010002001",
+			"Counsellor not found.",
+			err.Error(),
+		)
+	}
+
+	assignments, err := u.assignmentRepo.FindAssignmentsByCounsellor(counsellorObjectId)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010002002",
+			"Failed to get assignments.",
+			err.Error(),
+		)
+	}
+
+	return &GetAssignmentsOutput{
+		Items: assignments,
+	}, nil
+}
+
+func (u *CounsellingUseCaseImpl) RemoveAssignment(req *RemoveAssignmentRequest) error {
+	err := u.assignmentRepo.RemoveAssignmentById(req.Assignment)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010003001",
+			"Failed to remove assignment.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+func (u *CounsellingUseCaseImpl) FindAccessLogs(req *GetAccessLogsRequest) (*GetAccessLogsOutput, error) {
+	var counsellorObjectId *primitive.ObjectID
+	if req.Counsellor != nil {
+		objectId, err := primitive.ObjectIDFromHex(*req.Counsellor)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE010004001",
+				"Counsellor not found.",
+				err.Error(),
+			)
+		}
+		counsellorObjectId = &objectId
+	}
+
+	accessLogs, total, err := u.accessLogRepo.FindAccessLogs(counsellorObjectId, req.Page, req.Limit)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010004002",
+			"Failed to get access logs.",
+			err.Error(),
+		)
+	}
+
+	return &GetAccessLogsOutput{
+		Total: total,
+		Page:  req.Page,
+		Items: accessLogs,
+	}, nil
+}
+
+func (u *CounsellingUseCaseImpl) FindParticipants(claims *security.AccessTokenModel) (*GetParticipantsOutput, error) {
+	counsellorObjectId, err := primitive.ObjectIDFromHex(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010005001",
+			"Failed to check counsellor.",
+			err.Error(),
+		)
+	}
+
+	assignments, err := u.assignmentRepo.FindAssignmentsByCounsellor(counsellorObjectId)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010005002",
+			"Failed to get assignments.",
+			err.Error(),
+		)
+	}
+
+	userIds := make([]primitive.ObjectID, 0)
+	groupCodes := make([]string, 0)
+	for _, assignment := range *assignments {
+		if assignment.User != nil {
+			userIds = append(userIds, *assignment.User)
+		}
+		if assignment.GroupCode != nil {
+			groupCodes = append(groupCodes, *assignment.GroupCode)
+		}
+	}
+
+	users, err := u.userRepo.FindUsersByIdsOrGroupCodes(userIds, groupCodes)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010005003",
+			"Failed to get participants.",
+			err.Error(),
+		)
+	}
+
+	err = u.accessLogRepo.CreateAccessLog(counselling.NewAccessLog(counsellorObjectId, nil, counselling.AccessActionListParticipants, true))
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusInternalServerError,
+			"UCE010005004",
+			"Internal server error.",
+			err.Error(),
+		)
+	}
+
+	participants := make([]Participant, 0, len(*users))
+	for _, participant := range *users {
+		participants = append(participants, Participant{
+			ID:                participant.ID.Hex(),
+			Name:              participant.Name,
+			GroupCode:         participant.GroupCode,
+			CounsellorConsent: participant.CounsellorConsent,
+		})
+	}
+
+	return &GetParticipantsOutput{
+		Items: participants,
+	}, nil
+}
+
+func (u *CounsellingUseCaseImpl) FindParticipantRecords(userId string, claims *security.AccessTokenModel) (*GetParticipantRecordsOutput, error) {
+	participant, err := u.authorizeParticipant(claims, userId, counselling.AccessActionViewRecords, true)
+	if err != nil {
+		return nil, err
+	}
+
+	groupRecords, err := u.groupRecordRepo.FindGroupRecordsByUser(participant.ID, participantRecordLimit)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010006001",
+			"Failed to get records.",
+			err.Error(),
+		)
+	}
+
+	cardRecords, err := u.cardRecordRepo.FindCardRecordsByUser(participant.ID, participantRecordLimit)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010006002",
+			"Failed to get records.",
+			err.Error(),
+		)
+	}
+
+	flaggedStories, err := u.storyRecordRepo.FindFlaggedStoryRecordsByUser(participant.ID, participantRecordLimit)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010006003",
+			"Failed to get records.",
+			err.Error(),
+		)
+	}
+
+	return &GetParticipantRecordsOutput{
+		GroupRecords:   groupRecords,
+		CardRecords:    cardRecords,
+		FlaggedStories: flaggedStories,
+	}, nil
+}
+
+func (u *CounsellingUseCaseImpl) CreateNote(req *CreateNoteRequest, claims *security.AccessTokenModel) error {
+	participant, err := u.authorizeParticipant(claims, req.User, counselling.AccessActionCreateNote, false)
+	if err != nil {
+		return err
+	}
+
+	counsellorObjectId, _ := primitive.ObjectIDFromHex(claims.ID)
+	newNote := counselling.NewCounsellorNote(counsellorObjectId, participant.ID, req.Content)
+	err = u.counsellorNoteRepo.CreateCounsellorNote(newNote)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010007001",
+			"Failed to insert note.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+func (u *CounsellingUseCaseImpl) FindNotes(userId string, claims *security.AccessTokenModel) (*GetNotesOutput, error) {
+	participant, err := u.authorizeParticipant(claims, userId, counselling.AccessActionViewNotes, false)
+	if err != nil {
+		return nil, err
+	}
+
+	counsellorObjectId, _ := primitive.ObjectIDFromHex(claims.ID)
+	notes, err := u.counsellorNoteRepo.FindCounsellorNotes(counsellorObjectId, participant.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010008001",
+			"Failed to get notes.",
+			err.Error(),
+		)
+	}
+
+	return &GetNotesOutput{
+		Items: notes,
+	}, nil
+}
+
+// authorizeParticipant checks that the counsellor is assigned to the
+// participant (directly or via cohort) and, when requireConsent is set, that
+// the participant has consented. Every attempt is written to the access log.
+func (u *CounsellingUseCaseImpl) authorizeParticipant(claims *security.AccessTokenModel, userId, action string, requireConsent bool) (*user.User, error) {
+	counsellorObjectId, err := primitive.ObjectIDFromHex(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010009001",
+			"Failed to check counsellor.",
+			err.Error(),
+		)
+	}
+
+	participant, err := u.userRepo.FindUserById(userId)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010009002",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	assigned, err := u.assignmentRepo.IsAssigned(counsellorObjectId, participant.ID, participant.GroupCode)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE010009003",
+			"Failed to check assignment.",
+			err.Error(),
+		)
+	}
+
+	var denied error
+	switch {
+	case !assigned:
+		denied = errNotAssigned
+	case requireConsent && !participant.CounsellorConsent:
+		denied = errNoConsent
+	}
+
+	err = u.accessLogRepo.CreateAccessLog(counselling.NewAccessLog(counsellorObjectId, &participant.ID, action, denied == nil))
+	if denied != nil {
+		return nil, errors.NewCustomError(
+			http.StatusForbidden,
+			"UCE010009004",
+			"You do not have access to this participant.",
+			denied.Error(),
+		)
+	}
+
+	// ✅ Access that cannot be audited is not granted
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusInternalServerError,
+			"UCE010009005",
+			"Internal server error.",
+			err.Error(),
+		)
+	}
+
+	return participant, nil
+}
@@ -3,8 +3,9 @@ package health_score
 import "mucb_be/internal/domain/health_score"
 
 type CreateHealthScore struct {
-	Contents       []health_score.HealthScoreContent `json:"contents" binding:"required,dive,required"`
-	MaximumPercent int                               `json:"maximumPercent" binding:"required,min=1,max=100"`
+	Contents       []health_score.HealthScoreContent            `json:"contents" binding:"required,dive,required"`
+	Translations   map[string][]health_score.HealthScoreContent `json:"translations,omitempty" binding:"omitempty,dive,dive,required"`
+	MaximumPercent int                                          `json:"maximumPercent" binding:"required,min=1,max=100"`
 }
 
 type GetAllHealthScoreOutout struct {
@@ -16,13 +17,15 @@ type GetHealthScoreByIdOutout struct {
 }
 
 type UpdateHealthScoreByIdRequest struct {
-	HealthScore    string                            `json:"healthScore" binding:"required"`
-	Contents       []health_score.HealthScoreContent `json:"contents" binding:"required,dive,required"`
-	MaximumPercent int                               `json:"maximumPercent" binding:"required,min=1,max=100"`
+	HealthScore    string                                       `json:"healthScore" binding:"required"`
+	Contents       []health_score.HealthScoreContent            `json:"contents" binding:"required,dive,required"`
+	Translations   map[string][]health_score.HealthScoreContent `json:"translations,omitempty" binding:"omitempty,dive,dive,required"`
+	MaximumPercent int                                          `json:"maximumPercent" binding:"required,min=1,max=100"`
 }
 
 type GetContentByScoreRequest struct {
-	Score int `json:"score" binding:"required,min=0,max=100"`
+	Score          int    `json:"score" binding:"required,min=0,max=100"`
+	AcceptLanguage string `json:"-"`
 }
 
 type GetContentByScoreOutput struct {
@@ -1,9 +1,11 @@
 package health_score
 
+import "mucb_be/internal/infrastructure/security"
+
 type HealthScoreInterface interface {
-	CreateHealthScore(req *CreateHealthScore) error
+	CreateHealthScore(req *CreateHealthScore) (string, error)
 	FindAllHealthScore() (*GetAllHealthScoreOutout, error)
 	FindHealthScoreById(id string) (*GetHealthScoreByIdOutout, error)
 	UpdateHealthScoreById(req *UpdateHealthScoreByIdRequest) error
-	FindContentByScore(req *GetContentByScoreRequest) (*GetContentByScoreOutput, error)
+	FindContentByScore(req *GetContentByScoreRequest, claims *security.AccessTokenModel) (*GetContentByScoreOutput, error)
 }
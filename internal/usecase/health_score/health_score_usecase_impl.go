@@ -3,31 +3,47 @@ package health_score
 import (
 	"mucb_be/internal/domain/health_score"
 	"mucb_be/internal/domain/image"
+	"mucb_be/internal/domain/locale"
+	"mucb_be/internal/domain/user"
 	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/security"
 	"net/http"
 )
 
 type HealthScoreUseCaseImpl struct {
 	healthScoreRepo health_score.HealthScoreRepository
 	imageRepo       image.ImageRepository
+	userRepo        user.UserRepository
 }
 
 func NewHealthScoreUseCase(
 	healthScoreRepo health_score.HealthScoreRepository,
 	imageRepo image.ImageRepository,
+	userRepo user.UserRepository,
 ) HealthScoreInterface {
 	return &HealthScoreUseCaseImpl{
 		healthScoreRepo: healthScoreRepo,
 		imageRepo:       imageRepo,
+		userRepo:        userRepo,
 	}
 }
 
-func (u *HealthScoreUseCaseImpl) CreateHealthScore(req *CreateHealthScore) error {
-	newHealthScore := health_score.NewHealthScore(req.Contents, req.MaximumPercent)
-	err := u.healthScoreRepo.CreateHealthScore(newHealthScore)
+func (u *HealthScoreUseCaseImpl) CreateHealthScore(req *CreateHealthScore) (string, error) {
+	err := locale.Validate(req.Translations)
+	if err != nil {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE008001003",
+			"Invalid translations.",
+			err.Error(),
+		)
+	}
+
+	newHealthScore := health_score.NewHealthScore(req.Contents, req.Translations, req.MaximumPercent)
+	err = u.healthScoreRepo.CreateHealthScore(newHealthScore)
 	if err != nil {
 		if err.Error() == "duplicate maximum percent" {
-			return errors.NewCustomError(
+			return "", errors.NewCustomError(
 				http.StatusBadRequest,
 				"UCE008001001",
 				"Maximum percent duplicated.",
@@ -35,7 +51,7 @@ func (u *HealthScoreUseCaseImpl) CreateHealthScore(req *CreateHealthScore) error
 			)
 		}
 
-		return errors.NewCustomError(
+		return "", errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE008001002",
 			"Failed to insert health score.",
@@ -43,7 +59,7 @@ func (u *HealthScoreUseCaseImpl) CreateHealthScore(req *CreateHealthScore) error
 		)
 	}
 
-	return nil
+	return newHealthScore.ID.Hex(), nil
 }
 
 func (u *HealthScoreUseCaseImpl) FindAllHealthScore() (*GetAllHealthScoreOutout, error) {
@@ -91,7 +107,17 @@ func (u *HealthScoreUseCaseImpl) UpdateHealthScoreById(req *UpdateHealthScoreByI
 		)
 	}
 
-	err = u.healthScoreRepo.UpdateHealthScoreById(req.HealthScore, req.Contents, req.MaximumPercent)
+	err = locale.Validate(req.Translations)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE008004003",
+			"Invalid translations.",
+			err.Error(),
+		)
+	}
+
+	err = u.healthScoreRepo.UpdateHealthScoreById(req.HealthScore, req.Contents, req.Translations, req.MaximumPercent)
 	if err != nil {
 		return errors.NewCustomError(
 			http.StatusBadRequest,
@@ -101,13 +127,13 @@ func (u *HealthScoreUseCaseImpl) UpdateHealthScoreById(req *UpdateHealthScoreByI
 		)
 	}
 
-	for _, content := range healthScoreExist.Contents {
+	for _, content := range allContents(healthScoreExist.Contents, healthScoreExist.Translations) {
 		if content.ContentType == health_score.ContentTypeImage {
 			_ = u.imageRepo.UpdateImageStatusById(content.Content, false)
 		}
 	}
 
-	for _, content := range req.Contents {
+	for _, content := range allContents(req.Contents, req.Translations) {
 		if content.ContentType == health_score.ContentTypeImage {
 			_ = u.imageRepo.UpdateImageStatusById(content.Content, true)
 		}
@@ -116,7 +142,7 @@ func (u *HealthScoreUseCaseImpl) UpdateHealthScoreById(req *UpdateHealthScoreByI
 	return nil
 }
 
-func (u *HealthScoreUseCaseImpl) FindContentByScore(req *GetContentByScoreRequest) (*GetContentByScoreOutput, error) {
+func (u *HealthScoreUseCaseImpl) FindContentByScore(req *GetContentByScoreRequest, claims *security.AccessTokenModel) (*GetContentByScoreOutput, error) {
 	healthScore, err := u.healthScoreRepo.FindContentByScore(req.Score)
 	if err != nil {
 		return nil, errors.NewCustomError(
@@ -127,7 +153,22 @@ func (u *HealthScoreUseCaseImpl) FindContentByScore(req *GetContentByScoreReques
 		)
 	}
 
+	preferred := ""
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err == nil {
+		preferred = existUser.Language
+	}
+	healthScore.Localize(locale.Resolve(req.AcceptLanguage, preferred))
+
 	return &GetContentByScoreOutput{
 		HealthScore: healthScore,
 	}, nil
 }
+
+func allContents(contents []health_score.HealthScoreContent, translations map[string][]health_score.HealthScoreContent) []health_score.HealthScoreContent {
+	all := append([]health_score.HealthScoreContent{}, contents...)
+	for _, translated := range translations {
+		all = append(all, translated...)
+	}
+	return all
+}
@@ -1,14 +1,16 @@
 package image
 
-import "mucb_be/internal/domain/image"
+import (
+	"io"
+	"mucb_be/internal/domain/image"
+	"time"
+)
 
 type UploadImageRequest struct {
-	Path         string
+	File         io.Reader
 	Name         string
 	OriginalName string
-	Width        int
-	Height       int
-	ContentType  string
+	Extension    string
 }
 
 type UploadImageOutput struct {
@@ -18,3 +20,29 @@ type UploadImageOutput struct {
 type FindImageOutput struct {
 	image.Image
 }
+
+type FindImageVariantRequest struct {
+	Image string
+	Size  string
+	Width int
+}
+
+// FindImageVariantOutput describes the object to serve. ETag is empty for
+// images stored before content hashes were kept.
+type FindImageVariantOutput struct {
+	image.Image
+	Variant      string
+	Key          string
+	ETag         string
+	LastModified time.Time
+	CacheControl string
+}
+
+type ArchiveImageRequest struct {
+	Image    string `json:"image" binding:"required,len=24"`
+	Archived bool   `json:"archived"`
+}
+
+type RemoveImageRequest struct {
+	Image string `json:"image" binding:"required,len=24"`
+}
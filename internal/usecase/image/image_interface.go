@@ -1,8 +1,15 @@
 package image
 
-import "mucb_be/internal/infrastructure/security"
+import (
+	"io"
+	"mucb_be/internal/infrastructure/security"
+)
 
 type ImageInterface interface {
 	CreateImage(req UploadImageRequest, claims *security.AccessTokenModel) (*UploadImageOutput, error)
 	FindImageById(id string) (*FindImageOutput, error)
+	FindImageVariant(req *FindImageVariantRequest) (*FindImageVariantOutput, error)
+	OpenImageObject(key string) (io.ReadSeekCloser, error)
+	ArchiveImage(req *ArchiveImageRequest) error
+	RemoveImage(req *RemoveImageRequest) error
 }
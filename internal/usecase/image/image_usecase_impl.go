@@ -1,29 +1,140 @@
 package image
 
 import (
+	"bytes"
+	goerrors "errors"
+	goimage "image"
+	"io"
+	"log"
+	"mucb_be/internal/config"
 	"mucb_be/internal/domain/image"
 	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/clock"
+	"mucb_be/internal/infrastructure/imaging"
 	"mucb_be/internal/infrastructure/security"
+	"mucb_be/internal/infrastructure/storage"
 	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	maxUploadBytes       = 20 << 20
+	defaultCacheActive   = "public, max-age=604800"
+	defaultCacheInactive = "private, max-age=300"
+	defaultCacheArchived = "no-cache"
 )
 
 type ImageUseCaseImpl struct {
-	imageRepo image.ImageRepository
+	cfg            *config.Config
+	imageRepo      image.ImageRepository
+	objectStorage  storage.ObjectStorageInterface
+	imageProcessor imaging.ImageProcessorInterface
+	clock          clock.ClockInterface
 }
 
 func NewImageUseCase(
+	cfg *config.Config,
 	imageRepo image.ImageRepository,
+	objectStorage storage.ObjectStorageInterface,
+	imageProcessor imaging.ImageProcessorInterface,
+	clock clock.ClockInterface,
 ) ImageInterface {
 	return &ImageUseCaseImpl{
-		imageRepo: imageRepo,
+		cfg:            cfg,
+		imageRepo:      imageRepo,
+		objectStorage:  objectStorage,
+		imageProcessor: imageProcessor,
+		clock:          clock,
 	}
 }
 
+// CreateImage validates an upload by its content, stores it re-encoded
+// without metadata and returns the existing image instead when the same
+// content was uploaded before and is not archived.
 func (u *ImageUseCaseImpl) CreateImage(req UploadImageRequest, claims *security.AccessTokenModel) (*UploadImageOutput, error) {
-	newImage := image.NewImage(req.Path, req.Name, req.OriginalName, req.ContentType, req.Width, req.Height)
+	data, err := io.ReadAll(io.LimitReader(req.File, maxUploadBytes+1))
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006001003",
+			"Failed to read image.",
+			err.Error(),
+		)
+	}
+
+	if len(data) > maxUploadBytes {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006001004",
+			"Image file is too large.",
+			"",
+		)
+	}
+
+	sanitized, err := u.imageProcessor.Sanitize(data, req.Extension)
+	if err != nil {
+		code, message := "UCE006001005", "Invalid image format."
+		switch {
+		case goerrors.Is(err, imaging.ErrUnsupportedType), goerrors.Is(err, imaging.ErrTypeMismatch):
+			code, message = "UCE006001006", "File type not allowed."
+		case goerrors.Is(err, imaging.ErrPolyglot):
+			code, message = "UCE006001007", "Image contains unexpected data."
+		case goerrors.Is(err, imaging.ErrTooLarge):
+			code, message = "UCE006001008", "Image dimensions are too large."
+		}
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			code,
+			message,
+			err.Error(),
+		)
+	}
+
+	existImage, err := u.imageRepo.FindImageByHash(sanitized.Hash)
+	if err == nil {
+		return &UploadImageOutput{
+			Image: *existImage,
+		}, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006001009",
+			"Failed to check duplicate images.",
+			err.Error(),
+		)
+	}
+
+	err = u.objectStorage.Put(req.Name, bytes.NewReader(sanitized.Data), sanitized.ContentType)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusInternalServerError,
+			"UCE006001002",
+			"Failed to save image.",
+			err.Error(),
+		)
+	}
+
+	// ✅ The object key is the generated file name on every backend
+	newImage := image.NewImage(req.Name, req.Name, req.OriginalName, sanitized.ContentType, sanitized.Hash, sanitized.Width, sanitized.Height)
+	newImage.Variants = u.createVariants(newImage, sanitized)
 
-	err := u.imageRepo.CreateImage(newImage)
+	err = u.imageRepo.CreateImage(newImage)
 	if err != nil {
+		u.removeObjects(newImage)
+
+		// ✅ A concurrent upload of the same content won the insert
+		if goerrors.Is(err, image.ErrDuplicateHash) {
+			existImage, findErr := u.imageRepo.FindImageByHash(sanitized.Hash)
+			if findErr == nil {
+				return &UploadImageOutput{
+					Image: *existImage,
+				}, nil
+			}
+		}
+
 		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE006001001",
@@ -39,6 +150,116 @@ func (u *ImageUseCaseImpl) CreateImage(req UploadImageRequest, claims *security.
 	return &output, nil
 }
 
+// createVariants scales a new upload down to every configured variant that
+// is narrower than the image. A variant that fails is left out and created
+// on its first request instead.
+func (u *ImageUseCaseImpl) createVariants(newImage *image.Image, sanitized *imaging.Sanitized) []image.ImageVariant {
+	variants := make([]image.ImageVariant, 0)
+
+	for _, variant := range u.imageProcessor.Variants() {
+		if variant.Width >= sanitized.Width {
+			continue
+		}
+
+		imageVariant, err := u.storeVariant(newImage, sanitized.Image, sanitized.Format, variant)
+		if err != nil {
+			log.Printf("Failed to create %s variant of image %s: %v", variant.Name, newImage.ID.Hex(), err)
+			continue
+		}
+		variants = append(variants, *imageVariant)
+	}
+
+	return variants
+}
+
+func (u *ImageUseCaseImpl) storeVariant(imageExist *image.Image, source goimage.Image, format string, variant imaging.Variant) (*image.ImageVariant, error) {
+	resized, err := u.imageProcessor.Resize(source, format, variant.Width)
+	if err != nil {
+		return nil, err
+	}
+
+	key := imageExist.VariantKey(variant.Name)
+	err = u.objectStorage.Put(key, bytes.NewReader(resized.Data), resized.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &image.ImageVariant{
+		Name:        variant.Name,
+		Key:         key,
+		Width:       resized.Width,
+		Height:      resized.Height,
+		ContentType: resized.ContentType,
+		Hash:        resized.Hash,
+	}, nil
+}
+
+// lazyVariant creates a variant that is configured but missing, for images
+// uploaded before it was added or whose variant failed on upload.
+func (u *ImageUseCaseImpl) lazyVariant(imageExist *image.Image, variant imaging.Variant) (*image.ImageVariant, error) {
+	body, _, err := u.objectStorage.Get(imageExist.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	source, format, err := u.imageProcessor.Decode(body)
+	if err != nil {
+		return nil, err
+	}
+
+	imageVariant, err := u.storeVariant(imageExist, source, format, variant)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.imageRepo.AddImageVariant(imageExist.ID.Hex(), *imageVariant)
+	if err != nil {
+		return nil, err
+	}
+
+	return imageVariant, nil
+}
+
+// pickVariant chooses the variant for a request. A size names a variant,
+// a width picks the narrowest variant at least that wide, and nil means the
+// original is served.
+func (u *ImageUseCaseImpl) pickVariant(imageExist *image.Image, req *FindImageVariantRequest) (*imaging.Variant, bool) {
+	variants := u.imageProcessor.Variants()
+
+	if req.Size != "" {
+		if req.Size == image.VariantOriginal {
+			return nil, true
+		}
+		for i := range variants {
+			if variants[i].Name == req.Size {
+				if variants[i].Width >= imageExist.Width {
+					return nil, true
+				}
+				return &variants[i], true
+			}
+		}
+		return nil, false
+	}
+
+	if req.Width > 0 {
+		for i := range variants {
+			if variants[i].Width >= req.Width && variants[i].Width < imageExist.Width {
+				return &variants[i], true
+			}
+		}
+	}
+
+	return nil, true
+}
+
+func (u *ImageUseCaseImpl) removeObjects(imageExist *image.Image) {
+	_ = u.objectStorage.Delete(imageExist.Name)
+	for _, variant := range imageExist.Variants {
+		_ = u.objectStorage.Delete(variant.Key)
+	}
+}
+
 func (u *ImageUseCaseImpl) FindImageById(id string) (*FindImageOutput, error) {
 	imageExist, err := u.imageRepo.FindImageByID(id)
 	if err != nil {
@@ -56,3 +277,200 @@ func (u *ImageUseCaseImpl) FindImageById(id string) (*FindImageOutput, error) {
 
 	return &output, nil
 }
+
+// FindImageVariant picks the variant that best fits the request and returns
+// what is needed to serve it, so conditional requests are answered without
+// reading the object. A missing variant is created on the way, and the
+// original is served when that fails.
+func (u *ImageUseCaseImpl) FindImageVariant(req *FindImageVariantRequest) (*FindImageVariantOutput, error) {
+	imageExist, err := u.imageRepo.FindImageByID(req.Image)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006005001",
+			"Failed to find image.",
+			err.Error(),
+		)
+	}
+
+	variant, ok := u.pickVariant(imageExist, req)
+	if !ok {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006005002",
+			"Unknown image size.",
+			"",
+		)
+	}
+
+	output := FindImageVariantOutput{
+		Image:        *imageExist,
+		Variant:      image.VariantOriginal,
+		Key:          imageExist.Name,
+		ETag:         entityTag(imageExist.Hash),
+		LastModified: imageExist.UpdatedAt,
+		CacheControl: u.cacheControl(imageExist),
+	}
+
+	if variant != nil {
+		imageVariant := imageExist.Variant(variant.Name)
+		if imageVariant == nil {
+			imageVariant, err = u.lazyVariant(imageExist, *variant)
+			if err != nil {
+				log.Printf("Failed to create %s variant of image %s: %v", variant.Name, imageExist.ID.Hex(), err)
+			}
+		}
+		if imageVariant != nil {
+			output.Variant = imageVariant.Name
+			output.Key = imageVariant.Key
+			output.ETag = entityTag(imageVariant.Hash)
+			output.Width = imageVariant.Width
+			output.Height = imageVariant.Height
+			output.ContentType = imageVariant.ContentType
+		}
+	}
+
+	return &output, nil
+}
+
+// OpenImageObject opens a stored image or variant for reading. The caller
+// closes it.
+func (u *ImageUseCaseImpl) OpenImageObject(key string) (io.ReadSeekCloser, error) {
+	body, _, err := u.objectStorage.Get(key)
+	if goerrors.Is(err, storage.ErrObjectNotFound) {
+		return nil, errors.NewCustomError(
+			http.StatusNotFound,
+			"UCE006006001",
+			"Image file not found.",
+			err.Error(),
+		)
+	}
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusInternalServerError,
+			"UCE006006002",
+			"Failed to read image.",
+			err.Error(),
+		)
+	}
+
+	seeker, err := storage.Seekable(body)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusInternalServerError,
+			"UCE006006002",
+			"Failed to read image.",
+			err.Error(),
+		)
+	}
+
+	return seeker, nil
+}
+
+// cacheControl returns the Cache-Control header for the image status. Images
+// in use rarely change, unused ones may still be replaced and archived ones
+// should be checked again before they are shown.
+func (u *ImageUseCaseImpl) cacheControl(imageExist *image.Image) string {
+	switch imageExist.Status() {
+	case image.StatusActive:
+		if u.cfg.ImageCacheActive != "" {
+			return u.cfg.ImageCacheActive
+		}
+		return defaultCacheActive
+	case image.StatusArchived:
+		if u.cfg.ImageCacheArchived != "" {
+			return u.cfg.ImageCacheArchived
+		}
+		return defaultCacheArchived
+	default:
+		if u.cfg.ImageCacheInactive != "" {
+			return u.cfg.ImageCacheInactive
+		}
+		return defaultCacheInactive
+	}
+}
+
+// entityTag quotes a content hash as a strong ETag.
+func entityTag(hash string) string {
+	if hash == "" {
+		return ""
+	}
+	return `"` + hash + `"`
+}
+
+// ArchiveImage keeps an image from being put on new cards. It is still served
+// to whatever already shows it.
+func (u *ImageUseCaseImpl) ArchiveImage(req *ArchiveImageRequest) error {
+	_, err := u.imageRepo.FindImageByID(req.Image)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006003001",
+			"Failed to find image.",
+			err.Error(),
+		)
+	}
+
+	var archivedAt *time.Time
+	if req.Archived {
+		now := u.clock.Now()
+		archivedAt = &now
+	}
+
+	err = u.imageRepo.ArchiveImageById(req.Image, archivedAt)
+	if goerrors.Is(err, image.ErrDuplicateHash) {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006003003",
+			"An image with the same content is already in use.",
+			err.Error(),
+		)
+	}
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006003002",
+			"Failed to archive image.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+// RemoveImage deletes an image and its file once no card or health score
+// shows it.
+func (u *ImageUseCaseImpl) RemoveImage(req *RemoveImageRequest) error {
+	imageExist, err := u.imageRepo.FindImageByID(req.Image)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006004001",
+			"Failed to find image.",
+			err.Error(),
+		)
+	}
+
+	err = u.imageRepo.RemoveImageById(req.Image)
+	if goerrors.Is(err, image.ErrImageInUse) {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006004004",
+			"Image is in use, archive it instead.",
+			"",
+		)
+	}
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE006004005",
+			"Failed to remove image.",
+			err.Error(),
+		)
+	}
+
+	// ✅ The record is gone, a file left behind is never served again
+	u.removeObjects(imageExist)
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package image
+
+import (
+	"bytes"
+	"io"
+	"mucb_be/internal/domain/image"
+	"mucb_be/internal/infrastructure/imaging"
+	"mucb_be/internal/infrastructure/storage"
+	"sort"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type fakeObjectStorage struct {
+	deleted []string
+}
+
+func (s *fakeObjectStorage) Put(key string, body io.Reader, contentType string) error {
+	return nil
+}
+
+func (s *fakeObjectStorage) Get(key string) (io.ReadCloser, *storage.ObjectInfo, error) {
+	return nil, nil, storage.ErrObjectNotFound
+}
+
+func (s *fakeObjectStorage) Delete(key string) error {
+	s.deleted = append(s.deleted, key)
+	return nil
+}
+
+type fakeImageRepository struct {
+	image.ImageRepository
+	image   *image.Image
+	removed bool
+}
+
+func (r *fakeImageRepository) FindImageByID(id string) (*image.Image, error) {
+	return r.image, nil
+}
+
+func (r *fakeImageRepository) RemoveImageById(id string) error {
+	r.removed = true
+	return nil
+}
+
+// fakeRacingImageRepository behaves as if another upload of the same content
+// was inserted between the hash lookup and the insert.
+type fakeRacingImageRepository struct {
+	image.ImageRepository
+	winner   *image.Image
+	inserted bool
+}
+
+func (r *fakeRacingImageRepository) FindImageByHash(hash string) (*image.Image, error) {
+	if !r.inserted {
+		return nil, mongo.ErrNoDocuments
+	}
+	return r.winner, nil
+}
+
+func (r *fakeRacingImageRepository) CreateImage(newImage *image.Image) error {
+	r.inserted = true
+	return image.ErrDuplicateHash
+}
+
+type fakeImageProcessor struct {
+	imaging.ImageProcessorInterface
+}
+
+func (p *fakeImageProcessor) Sanitize(data []byte, extension string) (*imaging.Sanitized, error) {
+	return &imaging.Sanitized{Data: data, ContentType: "image/png", Width: 1, Height: 1, Hash: "hash"}, nil
+}
+
+func (p *fakeImageProcessor) Variants() []imaging.Variant {
+	return nil
+}
+
+func TestRemoveImageDeletesOriginalAndVariants(t *testing.T) {
+	imageExist := image.NewImage("a.png", "a.png", "cat.png", "image/png", "", 800, 600)
+	imageExist.Variants = []image.ImageVariant{
+		{Name: "thumbnail", Key: "a_thumbnail.png"},
+		{Name: "medium", Key: "a_medium.png"},
+	}
+
+	imageRepo := &fakeImageRepository{image: imageExist}
+	objectStorage := &fakeObjectStorage{}
+	useCase := &ImageUseCaseImpl{
+		imageRepo:     imageRepo,
+		objectStorage: objectStorage,
+	}
+
+	err := useCase.RemoveImage(&RemoveImageRequest{Image: imageExist.ID.Hex()})
+	if err != nil {
+		t.Fatalf("RemoveImage returned %v", err)
+	}
+
+	if !imageRepo.removed {
+		t.Fatal("image document was not removed")
+	}
+
+	sort.Strings(objectStorage.deleted)
+	want := []string{"a.png", "a_medium.png", "a_thumbnail.png"}
+	if len(objectStorage.deleted) != len(want) {
+		t.Fatalf("deleted %v, want %v", objectStorage.deleted, want)
+	}
+	for i := range want {
+		if objectStorage.deleted[i] != want[i] {
+			t.Fatalf("deleted %v, want %v", objectStorage.deleted, want)
+		}
+	}
+}
+
+func TestCreateImageReturnsImageThatWonConcurrentUpload(t *testing.T) {
+	winner := image.NewImage("b.png", "b.png", "cat.png", "image/png", "hash", 1, 1)
+	objectStorage := &fakeObjectStorage{}
+	useCase := &ImageUseCaseImpl{
+		imageRepo:      &fakeRacingImageRepository{winner: winner},
+		objectStorage:  objectStorage,
+		imageProcessor: &fakeImageProcessor{},
+	}
+
+	output, err := useCase.CreateImage(UploadImageRequest{
+		File:      bytes.NewReader([]byte("png")),
+		Name:      "a.png",
+		Extension: ".png",
+	}, nil)
+	if err != nil {
+		t.Fatalf("CreateImage returned %v", err)
+	}
+
+	if output.ID != winner.ID {
+		t.Fatalf("returned image %s, want %s", output.ID.Hex(), winner.ID.Hex())
+	}
+
+	if len(objectStorage.deleted) != 1 || objectStorage.deleted[0] != "a.png" {
+		t.Fatalf("deleted %v, want the object of the losing upload", objectStorage.deleted)
+	}
+}
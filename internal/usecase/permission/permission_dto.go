@@ -0,0 +1,21 @@
+package permission
+
+type RolePermissionItem struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+type GetAllRolePermissionsOutput struct {
+	Permissions []string             `json:"permissions"`
+	Items       []RolePermissionItem `json:"items"`
+}
+
+type UpdateRolePermissionRequest struct {
+	Role        string   `json:"role" binding:"required"`
+	Permissions []string `json:"permissions" binding:"required,dive,required"`
+}
+
+type GetMyPermissionsOutput struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
@@ -0,0 +1,9 @@
+package permission
+
+import "mucb_be/internal/infrastructure/security"
+
+type PermissionInterface interface {
+	FindAllRolePermissions() (*GetAllRolePermissionsOutput, error)
+	UpdateRolePermission(req *UpdateRolePermissionRequest) error
+	FindMyPermissions(claims *security.AccessTokenModel) *GetMyPermissionsOutput
+}
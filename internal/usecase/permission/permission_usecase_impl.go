@@ -0,0 +1,119 @@
+package permission
+
+import (
+	"mucb_be/internal/domain/admin"
+	"mucb_be/internal/domain/permission"
+	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/authorization"
+	"mucb_be/internal/infrastructure/security"
+	"net/http"
+)
+
+type PermissionUseCaseImpl struct {
+	rolePermissionRepo   permission.RolePermissionRepository
+	authorizationService authorization.AuthorizationServiceInterface
+}
+
+func NewPermissionUseCase(
+	rolePermissionRepo permission.RolePermissionRepository,
+	authorizationService authorization.AuthorizationServiceInterface,
+) PermissionInterface {
+	return &PermissionUseCaseImpl{
+		rolePermissionRepo:   rolePermissionRepo,
+		authorizationService: authorizationService,
+	}
+}
+
+func (u *PermissionUseCaseImpl) FindAllRolePermissions() (*GetAllRolePermissionsOutput, error) {
+	rolePermissions, err := u.rolePermissionRepo.FindAllRolePermissions()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE011001001",
+			"Failed to find role permissions.",
+			err.Error(),
+		)
+	}
+
+	stored := make(map[string][]string, len(*rolePermissions))
+	for _, rolePermission := range *rolePermissions {
+		stored[rolePermission.Role] = rolePermission.Permissions
+	}
+
+	items := make([]RolePermissionItem, 0, len(permission.Roles))
+	for _, role := range permission.Roles {
+		permissions := stored[role]
+		if permissions == nil {
+			permissions = []string{}
+		}
+		items = append(items, RolePermissionItem{
+			Role:        role,
+			Permissions: permissions,
+		})
+	}
+
+	return &GetAllRolePermissionsOutput{
+		Permissions: permission.All,
+		Items:       items,
+	}, nil
+}
+
+func (u *PermissionUseCaseImpl) UpdateRolePermission(req *UpdateRolePermissionRequest) error {
+	if !permission.IsValidRole(req.Role) {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE011002001",
+			"Invalid role.",
+			req.Role,
+		)
+	}
+
+	seen := make(map[string]bool, len(req.Permissions))
+	permissions := make([]string, 0, len(req.Permissions))
+	for _, name := range req.Permissions {
+		if !permission.IsValid(name) {
+			return errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE011002002",
+				"Invalid permission.",
+				name,
+			)
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		permissions = append(permissions, name)
+	}
+
+	// ✅ Keep at least one role able to edit permissions
+	if req.Role == admin.RoleSuperAdmin && !seen[permission.PermissionWrite] {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE011002003",
+			"Super admin must keep permission:write.",
+			"",
+		)
+	}
+
+	err := u.rolePermissionRepo.UpdatePermissionsByRole(req.Role, permissions)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE011002004",
+			"Failed to update role permissions.",
+			err.Error(),
+		)
+	}
+
+	u.authorizationService.Invalidate()
+
+	return nil
+}
+
+func (u *PermissionUseCaseImpl) FindMyPermissions(claims *security.AccessTokenModel) *GetMyPermissionsOutput {
+	return &GetMyPermissionsOutput{
+		Role:        claims.Role,
+		Permissions: u.authorizationService.PermissionsOf(claims.Role),
+	}
+}
@@ -1,16 +1,26 @@
 package question
 
-import "mucb_be/internal/domain/question"
+import (
+	"io"
+	"mucb_be/internal/domain/question"
+	"mucb_be/internal/domain/schedule"
+	"time"
+)
 
 type CreateQuestionGroupRequest struct {
-	ColumnName  string `json:"columnName" binding:"required,max=64"`
-	Description string `json:"description" binding:"required,max=256"`
-	Limit       int    `json:"limit" binding:"required,max=10"`
+	ColumnName   string                                `json:"columnName" binding:"required,max=64"`
+	Description  string                                `json:"description" binding:"required,max=256"`
+	Limit        int                                   `json:"limit" binding:"required,max=10"`
+	Schedule     *schedule.Schedule                    `json:"schedule,omitempty" binding:"omitempty"`
+	Scale        *question.AnswerScale                 `json:"scale,omitempty" binding:"omitempty"`
+	Sampling     *question.Sampling                    `json:"sampling,omitempty" binding:"omitempty"`
+	Translations map[string]question.QuestionGroupText `json:"translations,omitempty" binding:"omitempty,dive"`
 }
 
 type GetQuestionGroupsRequest struct {
-	Page  int `json:"page" binding:"required,min=1"`
-	Limit int `json:"limit" binding:"required,min=1,max=50"`
+	Page     int  `json:"page" binding:"required,min=1"`
+	Limit    int  `json:"limit" binding:"required,min=1,max=50"`
+	Archived bool `json:"archived"`
 }
 
 type GetQuestionGroupsOutput struct {
@@ -20,23 +30,33 @@ type GetQuestionGroupsOutput struct {
 }
 
 type CreateQuestionChoiceRequest struct {
-	QuestionGroup string `json:"questionGroup" binding:"required,max=64"`
-	Question      string `json:"question" binding:"required,max=256"`
-	ShouldInvert  bool   `json:"ShouldInvert"`
+	QuestionGroup string                                 `json:"questionGroup" binding:"required,max=64"`
+	Question      string                                 `json:"question" binding:"required,max=256"`
+	ShouldInvert  bool                                   `json:"ShouldInvert"`
+	Pinned        bool                                   `json:"pinned"`
+	Position      int                                    `json:"position" binding:"min=0"`
+	Translations  map[string]question.QuestionChoiceText `json:"translations,omitempty" binding:"omitempty,dive"`
 }
 
 type GetAllQuestionChoiceByQuestionGroupOutput struct {
 	Items *[]question.QuestionChoice `json:"items"`
 }
 
+type GetQuestionWithRandomChoicesRequest struct {
+	AcceptLanguage string
+}
+
 type GetQuestionWithRandomChoicesOutout struct {
 	Items *[]question.GroupsWithRandomChoices `json:"items"`
 }
 
 type UpdateQuestionRequest struct {
-	ID           string `json:"id"`
-	Question     string `json:"question" binding:"required,max=256"`
-	ShouldInvert bool   `json:"ShouldInvert"`
+	ID           string                                 `json:"id"`
+	Question     string                                 `json:"question" binding:"required,max=256"`
+	ShouldInvert bool                                   `json:"ShouldInvert"`
+	Pinned       bool                                   `json:"pinned"`
+	Position     int                                    `json:"position" binding:"min=0"`
+	Translations map[string]question.QuestionChoiceText `json:"translations,omitempty" binding:"omitempty,dive"`
 }
 
 type RemoveChoiceRequest struct {
@@ -47,9 +67,95 @@ type RemoveQuestionGroupRequest struct {
 	ID string `json:"id"`
 }
 
+type ArchiveQuestionGroupRequest struct {
+	ID       string `json:"id" binding:"required,len=24"`
+	Archived bool   `json:"archived"`
+}
+
+type ArchiveChoiceRequest struct {
+	ID       string `json:"id" binding:"required,len=24"`
+	Archived bool   `json:"archived"`
+}
+
 type UpdateQuestionGroupRequest struct {
-	ID          string `json:"id"`
-	ColumnName  string `json:"columnName" binding:"required,max=64"`
-	Description string `json:"description" binding:"required,max=256"`
-	Limit       int    `json:"limit" binding:"required,max=10"`
+	ID           string                                `json:"id"`
+	ColumnName   string                                `json:"columnName" binding:"required,max=64"`
+	Description  string                                `json:"description" binding:"required,max=256"`
+	Limit        int                                   `json:"limit" binding:"required,max=10"`
+	Schedule     *schedule.Schedule                    `json:"schedule,omitempty" binding:"omitempty"`
+	Scale        *question.AnswerScale                 `json:"scale,omitempty" binding:"omitempty"`
+	Sampling     *question.Sampling                    `json:"sampling,omitempty" binding:"omitempty"`
+	Translations map[string]question.QuestionGroupText `json:"translations,omitempty" binding:"omitempty,dive"`
+}
+
+type UpdateQuestionGroupConditionsRequest struct {
+	QuestionGroup string               `json:"questionGroup" binding:"required,len=24"`
+	Conditions    []question.Condition `json:"conditions" binding:"max=10,dive"`
+}
+
+type QuestionGroupAvailability struct {
+	QuestionGroup string `json:"questionGroup"`
+	ColumnName    string `json:"columnName"`
+	schedule.Availability
+}
+
+type CheckAvailableQuestionOutput struct {
+	Available  bool                        `json:"available"`
+	NextWindow *schedule.Window            `json:"nextWindow"`
+	Groups     []QuestionGroupAvailability `json:"groups"`
+}
+
+type GetQuestionRevisionsOutput struct {
+	CurrentVersion int                          `json:"currentVersion"`
+	Draft          bool                         `json:"draft"`
+	Items          *[]question.QuestionRevision `json:"items"`
+}
+
+type DiffQuestionRevisionsRequest struct {
+	QuestionGroup string
+	From          int
+	To            int
+}
+
+type PublishQuestionGroupRequest struct {
+	QuestionGroup string     `json:"questionGroup" binding:"required"`
+	PublishAt     *time.Time `json:"publishAt,omitempty"`
+}
+
+type PreviewQuestionGroupsRequest struct {
+	QuestionGroup  *string
+	AcceptLanguage string
+}
+
+const (
+	QuestionBankFormatJSON = "json"
+	QuestionBankFormatCSV  = "csv"
+)
+
+type ImportQuestionBankRequest struct {
+	Format  string
+	Content io.Reader
+	DryRun  bool
+}
+
+type ImportQuestionBankOutput struct {
+	DryRun           bool                         `json:"dryRun"`
+	Applied          bool                         `json:"applied"`
+	CreatedGroups    int                          `json:"createdGroups"`
+	UpdatedGroups    int                          `json:"updatedGroups"`
+	UnchangedGroups  int                          `json:"unchangedGroups"`
+	CreatedChoices   int                          `json:"createdChoices"`
+	UpdatedChoices   int                          `json:"updatedChoices"`
+	UnchangedChoices int                          `json:"unchangedChoices"`
+	Errors           []question.QuestionBankError `json:"errors"`
+}
+
+type ExportQuestionBankRequest struct {
+	Format string
+}
+
+type ExportQuestionBankOutput struct {
+	ContentType string
+	FileName    string
+	Content     []byte
 }
@@ -6,15 +6,24 @@ import (
 )
 
 type QuestionInterface interface {
-	CreateQuestionGroup(req *CreateQuestionGroupRequest, claims *security.AccessTokenModel) error
+	CreateQuestionGroup(req *CreateQuestionGroupRequest, claims *security.AccessTokenModel) (string, error)
 	FindAllQuestionGroup(req *GetQuestionGroupsRequest, claims *security.AccessTokenModel) (*GetQuestionGroupsOutput, error)
-	CreateQuestionChoice(req *CreateQuestionChoiceRequest, claims *security.AccessTokenModel) error
-	FindAllQuestionChoiceByQuestionGroup(id string, claims *security.AccessTokenModel) (*GetAllQuestionChoiceByQuestionGroupOutput, error)
-	GetQuestionWithRandomChoices(claims *security.AccessTokenModel) (*GetQuestionWithRandomChoicesOutout, error)
+	CreateQuestionChoice(req *CreateQuestionChoiceRequest, claims *security.AccessTokenModel) (string, error)
+	FindAllQuestionChoiceByQuestionGroup(id string, archived bool, claims *security.AccessTokenModel) (*GetAllQuestionChoiceByQuestionGroupOutput, error)
+	GetQuestionWithRandomChoices(req *GetQuestionWithRandomChoicesRequest, claims *security.AccessTokenModel) (*GetQuestionWithRandomChoicesOutout, error)
 	UpdateQuestion(req *UpdateQuestionRequest) error
-	CheckAvailableQuestion(claims *security.AccessTokenModel) error
+	CheckAvailableQuestion(claims *security.AccessTokenModel) (*CheckAvailableQuestionOutput, error)
 	RemoveChoice(req *RemoveChoiceRequest) error
 	RemoveQuestionGroup(req *RemoveQuestionGroupRequest) error
+	ArchiveQuestionGroup(req *ArchiveQuestionGroupRequest) error
+	ArchiveChoice(req *ArchiveChoiceRequest) error
 	GetQuestionGroupById(id string) (*question.QuestionGroup, error)
 	UpdateQuestionGroup(req *UpdateQuestionGroupRequest) error
+	UpdateQuestionGroupConditions(req *UpdateQuestionGroupConditionsRequest) error
+	FindQuestionRevisions(id string) (*GetQuestionRevisionsOutput, error)
+	DiffQuestionRevisions(req *DiffQuestionRevisionsRequest) (*question.RevisionDiff, error)
+	PublishQuestionGroup(req *PublishQuestionGroupRequest) (*question.QuestionRevision, error)
+	PreviewQuestionGroups(req *PreviewQuestionGroupsRequest) (*GetQuestionWithRandomChoicesOutout, error)
+	ImportQuestionBank(req *ImportQuestionBankRequest) (*ImportQuestionBankOutput, error)
+	ExportQuestionBank(req *ExportQuestionBankRequest) (*ExportQuestionBankOutput, error)
 }
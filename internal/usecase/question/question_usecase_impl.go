@@ -1,41 +1,107 @@
 package question
 
 import (
+	"bytes"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"mucb_be/internal/domain/locale"
+	"mucb_be/internal/domain/permission"
 	"mucb_be/internal/domain/question"
-	"mucb_be/internal/domain/record"
+	"mucb_be/internal/domain/questionnaire"
+	"mucb_be/internal/domain/schedule"
 	"mucb_be/internal/domain/user"
 	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/authorization"
+	"mucb_be/internal/infrastructure/clock"
+	"mucb_be/internal/infrastructure/sampling"
+	"mucb_be/internal/infrastructure/scheduling"
 	"mucb_be/internal/infrastructure/security"
 	"net/http"
+	"reflect"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type QuestionUseCaseImpl struct {
-	questionGroupRepo  question.QuestionGroupRepository
-	questionChoiceRepo question.QuestionChoiceRepository
-	groupRecordRepo    record.GroupRecordRepository
+	questionGroupRepo    question.QuestionGroupRepository
+	questionChoiceRepo   question.QuestionChoiceRepository
+	questionRevisionRepo question.QuestionRevisionRepository
+	questionBankRepo     question.QuestionBankRepository
+	questionnaireRepo    questionnaire.QuestionnaireRepository
+	scheduleService      scheduling.ScheduleServiceInterface
+	availabilityService  scheduling.AvailabilityServiceInterface
+	choiceSampler        sampling.ChoiceSamplerInterface
+	userRepo             user.UserRepository
+	clock                clock.ClockInterface
+	authorizationService authorization.AuthorizationServiceInterface
 }
 
 func NewAdminUseCase(
 	questionGroupRepo question.QuestionGroupRepository,
 	questionChoiceRepo question.QuestionChoiceRepository,
-	groupRecordRepo record.GroupRecordRepository,
+	questionRevisionRepo question.QuestionRevisionRepository,
+	questionBankRepo question.QuestionBankRepository,
+	questionnaireRepo questionnaire.QuestionnaireRepository,
+	scheduleService scheduling.ScheduleServiceInterface,
+	availabilityService scheduling.AvailabilityServiceInterface,
+	choiceSampler sampling.ChoiceSamplerInterface,
+	userRepo user.UserRepository,
+	clock clock.ClockInterface,
+	authorizationService authorization.AuthorizationServiceInterface,
 ) QuestionInterface {
 	return &QuestionUseCaseImpl{
-		questionGroupRepo:  questionGroupRepo,
-		questionChoiceRepo: questionChoiceRepo,
-		groupRecordRepo:    groupRecordRepo,
+		questionGroupRepo:    questionGroupRepo,
+		questionChoiceRepo:   questionChoiceRepo,
+		questionRevisionRepo: questionRevisionRepo,
+		questionBankRepo:     questionBankRepo,
+		questionnaireRepo:    questionnaireRepo,
+		scheduleService:      scheduleService,
+		availabilityService:  availabilityService,
+		choiceSampler:        choiceSampler,
+		userRepo:             userRepo,
+		clock:                clock,
+		authorizationService: authorizationService,
 	}
 }
 
-func (u *QuestionUseCaseImpl) CreateQuestionGroup(req *CreateQuestionGroupRequest, claims *security.AccessTokenModel) error {
-	if claims.Role == user.RoleUser {
-		return errors.NewCustomError(
-			http.StatusForbidden,
-			"UCE004001001",
-			"Internal server error.",
-			"",
+func (u *QuestionUseCaseImpl) CreateQuestionGroup(req *CreateQuestionGroupRequest, claims *security.AccessTokenModel) (string, error) {
+	if err := u.authorizationService.Authorize(claims, permission.QuestionWrite); err != nil {
+		return "", err
+	}
+
+	if req.Schedule != nil {
+		err := u.scheduleService.Validate(req.Schedule)
+		if err != nil {
+			return "", errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004001003",
+				"Invalid schedule.",
+				err.Error(),
+			)
+		}
+	}
+
+	if req.Scale != nil {
+		err := req.Scale.Validate()
+		if err != nil {
+			return "", errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004001004",
+				"Invalid answer scale.",
+				err.Error(),
+			)
+		}
+	}
+
+	err := locale.Validate(req.Translations)
+	if err != nil {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004001005",
+			"Invalid translations.",
+			err.Error(),
 		)
 	}
 
@@ -43,11 +109,15 @@ func (u *QuestionUseCaseImpl) CreateQuestionGroup(req *CreateQuestionGroupReques
 		req.ColumnName,
 		req.Description,
 		req.Limit,
+		req.Schedule,
+		req.Scale,
+		req.Sampling,
+		req.Translations,
 	)
 
-	err := u.questionGroupRepo.CreateQuestionGroup(questionGroup)
+	err = u.questionGroupRepo.CreateQuestionGroup(questionGroup)
 	if err != nil {
-		return errors.NewCustomError(
+		return "", errors.NewCustomError(
 			http.StatusForbidden,
 			"UCE004001002",
 			"Can not create.",
@@ -55,20 +125,15 @@ func (u *QuestionUseCaseImpl) CreateQuestionGroup(req *CreateQuestionGroupReques
 		)
 	}
 
-	return nil
+	return questionGroup.ID.Hex(), nil
 }
 
 func (u *QuestionUseCaseImpl) FindAllQuestionGroup(req *GetQuestionGroupsRequest, claims *security.AccessTokenModel) (*GetQuestionGroupsOutput, error) {
-	if claims.Role == user.RoleUser {
-		return nil, errors.NewCustomError(
-			http.StatusForbidden,
-			"UCE004002001",
-			"Internal server error.",
-			"",
-		)
+	if err := u.authorizationService.Authorize(claims, permission.QuestionRead); err != nil {
+		return nil, err
 	}
 
-	groups, total, err := u.questionGroupRepo.FindAllQuestionGroup(req.Page, req.Limit)
+	groups, total, err := u.questionGroupRepo.FindAllQuestionGroup(req.Page, req.Limit, req.Archived)
 	if err != nil {
 		return nil, errors.NewCustomError(
 			http.StatusForbidden,
@@ -85,19 +150,14 @@ func (u *QuestionUseCaseImpl) FindAllQuestionGroup(req *GetQuestionGroupsRequest
 	}, nil
 }
 
-func (u *QuestionUseCaseImpl) CreateQuestionChoice(req *CreateQuestionChoiceRequest, claims *security.AccessTokenModel) error {
-	if claims.Role == user.RoleUser {
-		return errors.NewCustomError(
-			http.StatusForbidden,
-			"UCE004003001",
-			"Internal server error.",
-			"",
-		)
+func (u *QuestionUseCaseImpl) CreateQuestionChoice(req *CreateQuestionChoiceRequest, claims *security.AccessTokenModel) (string, error) {
+	if err := u.authorizationService.Authorize(claims, permission.QuestionWrite); err != nil {
+		return "", err
 	}
 
 	objectId, err := primitive.ObjectIDFromHex(req.QuestionGroup)
 	if err != nil {
-		return errors.NewCustomError(
+		return "", errors.NewCustomError(
 			http.StatusForbidden,
 			"UCE004003002",
 			"Question group not found.",
@@ -105,15 +165,47 @@ func (u *QuestionUseCaseImpl) CreateQuestionChoice(req *CreateQuestionChoiceRequ
 		)
 	}
 
-	questionGroup := question.NewQuestionChoice(
+	existQuestionGroup, err := u.questionGroupRepo.FindQuestionGroupById(req.QuestionGroup)
+	if err != nil {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004003004",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	if existQuestionGroup.ArchivedAt != nil {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004003007",
+			"Question group is archived.",
+			"",
+		)
+	}
+
+	err = locale.Validate(req.Translations)
+	if err != nil {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004003006",
+			"Invalid translations.",
+			err.Error(),
+		)
+	}
+
+	questionChoice := question.NewQuestionChoice(
 		objectId,
 		req.Question,
 		req.ShouldInvert,
+		req.Pinned,
+		req.Position,
+		req.Translations,
 	)
 
-	err = u.questionChoiceRepo.CreateQuestionChoice(questionGroup)
+	err = u.questionChoiceRepo.CreateQuestionChoice(questionChoice)
 	if err != nil {
-		return errors.NewCustomError(
+		return "", errors.NewCustomError(
 			http.StatusForbidden,
 			"UCE004003003",
 			"Can not create.",
@@ -121,17 +213,22 @@ func (u *QuestionUseCaseImpl) CreateQuestionChoice(req *CreateQuestionChoiceRequ
 		)
 	}
 
-	return nil
+	err = u.questionGroupRepo.MarkQuestionGroupDraft(req.QuestionGroup)
+	if err != nil {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004003005",
+			"Failed to mark question group as draft.",
+			err.Error(),
+		)
+	}
+
+	return questionChoice.ID.Hex(), nil
 }
 
-func (u *QuestionUseCaseImpl) FindAllQuestionChoiceByQuestionGroup(idStr string, claims *security.AccessTokenModel) (*GetAllQuestionChoiceByQuestionGroupOutput, error) {
-	if claims.Role == user.RoleUser {
-		return nil, errors.NewCustomError(
-			http.StatusForbidden,
-			"UCE004004001",
-			"Internal server error.",
-			"",
-		)
+func (u *QuestionUseCaseImpl) FindAllQuestionChoiceByQuestionGroup(idStr string, archived bool, claims *security.AccessTokenModel) (*GetAllQuestionChoiceByQuestionGroupOutput, error) {
+	if err := u.authorizationService.Authorize(claims, permission.QuestionRead); err != nil {
+		return nil, err
 	}
 
 	objectId, err := primitive.ObjectIDFromHex(idStr)
@@ -144,7 +241,7 @@ func (u *QuestionUseCaseImpl) FindAllQuestionChoiceByQuestionGroup(idStr string,
 		)
 	}
 
-	questions, err := u.questionChoiceRepo.FindAllQuestionChoiceByQuestionGroup(&objectId)
+	questions, err := u.questionChoiceRepo.FindAllQuestionChoiceByQuestionGroup(&objectId, archived)
 	if err != nil {
 		return nil, errors.NewCustomError(
 			http.StatusForbidden,
@@ -159,13 +256,23 @@ func (u *QuestionUseCaseImpl) FindAllQuestionChoiceByQuestionGroup(idStr string,
 	}, nil
 }
 
-func (u *QuestionUseCaseImpl) GetQuestionWithRandomChoices(claims *security.AccessTokenModel) (*GetQuestionWithRandomChoicesOutout, error) {
-	err := u.CheckAvailableQuestion(claims)
+func (u *QuestionUseCaseImpl) GetQuestionWithRandomChoices(req *GetQuestionWithRandomChoicesRequest, claims *security.AccessTokenModel) (*GetQuestionWithRandomChoicesOutout, error) {
+	availability, err := u.CheckAvailableQuestion(claims)
 	if err != nil {
 		return nil, err
 	}
 
-	questionGroup, err := u.questionGroupRepo.FindGroupsWithRandomChoices()
+	if !availability.Available {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004005001",
+			"No questionnaire is available right now.",
+			"",
+		)
+	}
+
+	now := u.clock.Now()
+	questionRevisions, err := u.questionRevisionRepo.FindLiveQuestionRevisions(now)
 	if err != nil {
 		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
@@ -175,13 +282,73 @@ func (u *QuestionUseCaseImpl) GetQuestionWithRandomChoices(claims *security.Acce
 		)
 	}
 
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004005003",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	availableGroups := make(map[string]bool)
+	for _, group := range availability.Groups {
+		availableGroups[group.QuestionGroup] = group.Available
+	}
+
+	// ✅ The flat exam has no answer order, so only the profile conditions
+	// can hold
+	state := question.NewBranchState(question.NewProfile(existUser.GroupCode, existUser.Language))
+
+	name := locale.Resolve(req.AcceptLanguage, existUser.Language)
+	items := make([]question.GroupsWithRandomChoices, 0)
+	for i := range *questionRevisions {
+		questionRevision := &(*questionRevisions)[i]
+		if !availableGroups[questionRevision.QuestionGroup.Hex()] || !questionRevision.Eligible(state) {
+			continue
+		}
+
+		item, err := u.choiceSampler.Sample(questionRevision, existUser, now)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004005004",
+				"Failed to draw choices.",
+				err.Error(),
+			)
+		}
+		item.Localize(name)
+		items = append(items, *item)
+	}
+
 	return &GetQuestionWithRandomChoicesOutout{
-		Items: questionGroup,
+		Items: &items,
 	}, nil
 }
 
 func (u *QuestionUseCaseImpl) UpdateQuestion(req *UpdateQuestionRequest) error {
-	err := u.questionChoiceRepo.UpdateQuestionChoiceById(req.ID, req.Question, req.ShouldInvert)
+	existChoice, err := u.questionChoiceRepo.FindQuestionChoiceById(req.ID)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004006002",
+			"Question choice not found.",
+			err.Error(),
+		)
+	}
+
+	err = locale.Validate(req.Translations)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004006004",
+			"Invalid translations.",
+			err.Error(),
+		)
+	}
+
+	err = u.questionChoiceRepo.UpdateQuestionChoiceById(req.ID, req.Question, req.ShouldInvert, req.Pinned, req.Position, req.Translations)
 	if err != nil {
 		return errors.NewCustomError(
 			http.StatusBadRequest,
@@ -191,13 +358,23 @@ func (u *QuestionUseCaseImpl) UpdateQuestion(req *UpdateQuestionRequest) error {
 		)
 	}
 
+	err = u.questionGroupRepo.MarkQuestionGroupDraft(existChoice.QuestionGroup.Hex())
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004006003",
+			"Failed to mark question group as draft.",
+			err.Error(),
+		)
+	}
+
 	return nil
 }
 
-func (u *QuestionUseCaseImpl) CheckAvailableQuestion(claims *security.AccessTokenModel) error {
+func (u *QuestionUseCaseImpl) CheckAvailableQuestion(claims *security.AccessTokenModel) (*CheckAvailableQuestionOutput, error) {
 	userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
 	if err != nil {
-		return errors.NewCustomError(
+		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE004007001",
 			"Failed to check user.",
@@ -205,30 +382,101 @@ func (u *QuestionUseCaseImpl) CheckAvailableQuestion(claims *security.AccessToke
 		)
 	}
 
-	isAlreadySubmit, err := u.groupRecordRepo.HasSubmittedToday(userObjectId)
+	now := u.clock.Now()
+
+	questionRevisions, err := u.questionRevisionRepo.FindLiveQuestionRevisions(now)
 	if err != nil {
-		return errors.NewCustomError(
+		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
-			"UCE004007002",
-			"Failed to check submission status.",
+			"UCE004007003",
+			"Question group not found.",
 			err.Error(),
 		)
 	}
 
-	if isAlreadySubmit {
-		return errors.NewCustomError(
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
-			"UCE004007003",
-			"You can only submit once per day",
-			"",
+			"UCE004007004",
+			"User not found.",
+			err.Error(),
 		)
 	}
 
-	return nil
+	location := existUser.Location()
+
+	// ✅ Only profile conditions can hold here; groups that depend on other
+	// answers are reached through the stepwise questionnaire exam
+	state := question.NewBranchState(question.NewProfile(existUser.GroupCode, existUser.Language))
+
+	output := CheckAvailableQuestionOutput{
+		Groups: make([]QuestionGroupAvailability, 0, len(*questionRevisions)),
+	}
+	for _, questionRevision := range *questionRevisions {
+		if !questionRevision.Eligible(state) {
+			continue
+		}
+
+		questionGroup := questionRevision.Snapshot()
+		availability, err := u.availabilityService.GroupAvailability(userObjectId, &questionGroup, now, location)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004007002",
+				"Failed to check submission status.",
+				err.Error(),
+			)
+		}
+
+		if availability.Available {
+			output.Available = true
+		} else if availability.NextWindow != nil {
+			output.NextWindow = earliestWindow(output.NextWindow, availability.NextWindow)
+		}
+
+		output.Groups = append(output.Groups, QuestionGroupAvailability{
+			QuestionGroup: questionGroup.ID.Hex(),
+			ColumnName:    questionGroup.ColumnName,
+			Availability:  *availability,
+		})
+	}
+
+	if output.Available {
+		output.NextWindow = nil
+	}
+
+	return &output, nil
+}
+
+func earliestWindow(current, candidate *schedule.Window) *schedule.Window {
+	if current == nil || candidate.StartAt.Before(current.StartAt) {
+		return candidate
+	}
+	return current
 }
 
 func (u *QuestionUseCaseImpl) RemoveChoice(req *RemoveChoiceRequest) error {
-	err := u.questionChoiceRepo.RemoveChoiceById(req.ID)
+	existChoice, err := u.questionChoiceRepo.FindQuestionChoiceById(req.ID)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004008002",
+			"Question choice not found.",
+			err.Error(),
+		)
+	}
+
+	// ✅ Answers keep pointing at the choice, so answered choices can only be archived
+	err = u.questionBankRepo.RemoveQuestionChoice(existChoice.QuestionGroup, existChoice.ID)
+	if goerrors.Is(err, question.ErrQuestionChoiceHasRecords) {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004008005",
+			"Question choice has records, archive it instead.",
+			"",
+		)
+	}
 	if err != nil {
 		return errors.NewCustomError(
 			http.StatusBadRequest,
@@ -241,8 +489,36 @@ func (u *QuestionUseCaseImpl) RemoveChoice(req *RemoveChoiceRequest) error {
 	return nil
 }
 
+// RemoveQuestionGroup deletes a group with its choices and revisions. Groups
+// that have records or belong to a questionnaire can only be archived.
 func (u *QuestionUseCaseImpl) RemoveQuestionGroup(req *RemoveQuestionGroupRequest) error {
-	err := u.questionGroupRepo.RemoveQuestionGroupById(req.ID)
+	existQuestionGroup, err := u.questionGroupRepo.FindQuestionGroupById(req.ID)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004009002",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	err = u.questionBankRepo.RemoveQuestionGroup(existQuestionGroup.ID)
+	if goerrors.Is(err, question.ErrQuestionGroupHasRecords) {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004009004",
+			"Question group has records, archive it instead.",
+			"",
+		)
+	}
+	if goerrors.Is(err, question.ErrQuestionGroupInUse) {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004009006",
+			"Question group is used by a questionnaire.",
+			"",
+		)
+	}
 	if err != nil {
 		return errors.NewCustomError(
 			http.StatusBadRequest,
@@ -252,11 +528,78 @@ func (u *QuestionUseCaseImpl) RemoveQuestionGroup(req *RemoveQuestionGroupReques
 		)
 	}
 
-	u.questionChoiceRepo.RemoveChoicesByQuestionGroupId(req.ID)
+	return nil
+}
+
+// ArchiveQuestionGroup hides a group from participants, listings and new
+// questionnaires while its revisions stay readable for existing records.
+func (u *QuestionUseCaseImpl) ArchiveQuestionGroup(req *ArchiveQuestionGroupRequest) error {
+	_, err := u.questionGroupRepo.FindQuestionGroupById(req.ID)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004019001",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	err = u.questionGroupRepo.ArchiveQuestionGroupById(req.ID, archivedAt(req.Archived, u.clock.Now()))
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004019002",
+			"Failed to archive question group.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+// ArchiveChoice leaves a choice out of the next revision of its group.
+// Published revisions keep their copy of the choice.
+func (u *QuestionUseCaseImpl) ArchiveChoice(req *ArchiveChoiceRequest) error {
+	existChoice, err := u.questionChoiceRepo.FindQuestionChoiceById(req.ID)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004020001",
+			"Question choice not found.",
+			err.Error(),
+		)
+	}
+
+	err = u.questionChoiceRepo.ArchiveChoiceById(req.ID, archivedAt(req.Archived, u.clock.Now()))
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004020002",
+			"Failed to archive choice.",
+			err.Error(),
+		)
+	}
+
+	err = u.questionGroupRepo.MarkQuestionGroupDraft(existChoice.QuestionGroup.Hex())
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004020003",
+			"Failed to mark question group as draft.",
+			err.Error(),
+		)
+	}
 
 	return nil
 }
 
+func archivedAt(archived bool, now time.Time) *time.Time {
+	if !archived {
+		return nil
+	}
+	return &now
+}
+
 func (u *QuestionUseCaseImpl) GetQuestionGroupById(id string) (*question.QuestionGroup, error) {
 	existQuestionGroup, err := u.questionGroupRepo.FindQuestionGroupById(id)
 	if err != nil {
@@ -272,7 +615,41 @@ func (u *QuestionUseCaseImpl) GetQuestionGroupById(id string) (*question.Questio
 }
 
 func (u *QuestionUseCaseImpl) UpdateQuestionGroup(req *UpdateQuestionGroupRequest) error {
-	err := u.questionGroupRepo.UpdateQuestionGroupById(req.ID, req.ColumnName, req.Description, req.Limit)
+	if req.Schedule != nil {
+		err := u.scheduleService.Validate(req.Schedule)
+		if err != nil {
+			return errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004011002",
+				"Invalid schedule.",
+				err.Error(),
+			)
+		}
+	}
+
+	if req.Scale != nil {
+		err := req.Scale.Validate()
+		if err != nil {
+			return errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004011003",
+				"Invalid answer scale.",
+				err.Error(),
+			)
+		}
+	}
+
+	err := locale.Validate(req.Translations)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004011004",
+			"Invalid translations.",
+			err.Error(),
+		)
+	}
+
+	err = u.questionGroupRepo.UpdateQuestionGroupById(req.ID, req.ColumnName, req.Description, req.Limit, req.Schedule, req.Scale, req.Sampling, req.Translations)
 	if err != nil {
 		return errors.NewCustomError(
 			http.StatusBadRequest,
@@ -284,3 +661,687 @@ func (u *QuestionUseCaseImpl) UpdateQuestionGroup(req *UpdateQuestionGroupReques
 
 	return nil
 }
+
+// UpdateQuestionGroupConditions replaces the rules deciding when a group is
+// shown. Like any other change it only reaches participants once published.
+func (u *QuestionUseCaseImpl) UpdateQuestionGroupConditions(req *UpdateQuestionGroupConditionsRequest) error {
+	existQuestionGroup, err := u.questionGroupRepo.FindQuestionGroupById(req.QuestionGroup)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004018001",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	for _, condition := range req.Conditions {
+		err := u.checkCondition(existQuestionGroup.ID, &condition)
+		if err != nil {
+			return errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004018002",
+				"Invalid condition.",
+				err.Error(),
+			)
+		}
+	}
+
+	err = u.questionGroupRepo.UpdateQuestionGroupConditions(req.QuestionGroup, req.Conditions)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004018003",
+			"Failed to update conditions.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+// checkCondition makes sure a condition refers to another group, or to a
+// choice of another group, that exists.
+func (u *QuestionUseCaseImpl) checkCondition(questionGroup primitive.ObjectID, condition *question.Condition) error {
+	if err := condition.Validate(); err != nil {
+		return err
+	}
+
+	switch condition.Type {
+	case question.ConditionScore:
+		if *condition.QuestionGroup == questionGroup {
+			return fmt.Errorf("a question group can not depend on its own score")
+		}
+		_, err := u.questionGroupRepo.FindQuestionGroupById(condition.QuestionGroup.Hex())
+		if err != nil {
+			return fmt.Errorf("question group %s: %w", condition.QuestionGroup.Hex(), err)
+		}
+	case question.ConditionAnswer:
+		existChoice, err := u.questionChoiceRepo.FindQuestionChoiceById(condition.Choice.Hex())
+		if err != nil {
+			return fmt.Errorf("question choice %s: %w", condition.Choice.Hex(), err)
+		}
+		if existChoice.QuestionGroup == questionGroup {
+			return fmt.Errorf("a question group can not depend on its own choices")
+		}
+	}
+
+	return nil
+}
+
+func (u *QuestionUseCaseImpl) FindQuestionRevisions(id string) (*GetQuestionRevisionsOutput, error) {
+	existQuestionGroup, err := u.questionGroupRepo.FindQuestionGroupById(id)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004012001",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	questionRevisions, err := u.questionRevisionRepo.FindQuestionRevisionsByQuestionGroup(existQuestionGroup.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004012002",
+			"Failed to find revisions.",
+			err.Error(),
+		)
+	}
+
+	return &GetQuestionRevisionsOutput{
+		CurrentVersion: existQuestionGroup.Version,
+		Draft:          existQuestionGroup.Draft,
+		Items:          questionRevisions,
+	}, nil
+}
+
+func (u *QuestionUseCaseImpl) DiffQuestionRevisions(req *DiffQuestionRevisionsRequest) (*question.RevisionDiff, error) {
+	questionGroupObjectId, err := primitive.ObjectIDFromHex(req.QuestionGroup)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004013001",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	from, err := u.questionRevisionRepo.FindQuestionRevisionByVersion(questionGroupObjectId, req.From)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004013002",
+			"Revision not found.",
+			err.Error(),
+		)
+	}
+
+	to, err := u.questionRevisionRepo.FindQuestionRevisionByVersion(questionGroupObjectId, req.To)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004013003",
+			"Revision not found.",
+			err.Error(),
+		)
+	}
+
+	return question.DiffRevisions(from, to), nil
+}
+
+func (u *QuestionUseCaseImpl) PublishQuestionGroup(req *PublishQuestionGroupRequest) (*question.QuestionRevision, error) {
+	existQuestionGroup, err := u.questionGroupRepo.FindQuestionGroupById(req.QuestionGroup)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004014001",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	if existQuestionGroup.ArchivedAt != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004014008",
+			"Question group is archived.",
+			"",
+		)
+	}
+
+	if !existQuestionGroup.Draft {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004014002",
+			"Nothing to publish.",
+			"",
+		)
+	}
+
+	choices, err := u.questionChoiceRepo.FindAllQuestionChoiceByQuestionGroup(&existQuestionGroup.ID, false)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004014003",
+			"Failed to find choices.",
+			err.Error(),
+		)
+	}
+
+	if len(*choices) == 0 {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004014004",
+			"Question group has no choices.",
+			"",
+		)
+	}
+
+	publishedAt := u.clock.Now()
+	if req.PublishAt != nil && req.PublishAt.After(publishedAt) {
+		publishedAt = *req.PublishAt
+	}
+
+	// ✅ The revision is frozen now even when it goes live later
+	questionRevision := question.NewQuestionRevision(existQuestionGroup, *choices, existQuestionGroup.Version+1, publishedAt)
+	err = u.questionBankRepo.PublishQuestionGroup(questionRevision)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004014005",
+			"Failed to publish question group.",
+			err.Error(),
+		)
+	}
+
+	return questionRevision, nil
+}
+
+// PreviewQuestionGroups renders the current drafts the way /question/exam
+// renders published revisions.
+func (u *QuestionUseCaseImpl) PreviewQuestionGroups(req *PreviewQuestionGroupsRequest) (*GetQuestionWithRandomChoicesOutout, error) {
+	var questionGroups []question.QuestionGroup
+	if req.QuestionGroup != nil {
+		existQuestionGroup, err := u.questionGroupRepo.FindQuestionGroupById(*req.QuestionGroup)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004015001",
+				"Question group not found.",
+				err.Error(),
+			)
+		}
+		questionGroups = append(questionGroups, *existQuestionGroup)
+	} else {
+		existQuestionGroups, err := u.questionGroupRepo.FindQuestionGroups()
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004015002",
+				"Question group not found.",
+				err.Error(),
+			)
+		}
+		for _, questionGroup := range *existQuestionGroups {
+			if questionGroup.ArchivedAt == nil {
+				questionGroups = append(questionGroups, questionGroup)
+			}
+		}
+	}
+
+	items := make([]question.GroupsWithRandomChoices, 0, len(questionGroups))
+	for _, questionGroup := range questionGroups {
+		choices, err := u.questionChoiceRepo.FindAllQuestionChoiceByQuestionGroup(&questionGroup.ID, false)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE004015003",
+				"Failed to find choices.",
+				err.Error(),
+			)
+		}
+
+		draft := question.NewQuestionRevision(&questionGroup, *choices, questionGroup.Version+1, u.clock.Now())
+		draft.ID = primitive.NilObjectID
+		item := question.NewGroupsWithRandomChoices(draft, question.ChoiceDraw{})
+		item.Localize(locale.Resolve(req.AcceptLanguage, ""))
+		items = append(items, item)
+	}
+
+	return &GetQuestionWithRandomChoicesOutout{
+		Items: &items,
+	}, nil
+}
+
+// ImportQuestionBank creates or updates groups and choices from a bank. Rows
+// are matched by id first, then by column name for groups and by question
+// text within the group for choices; ids that do not exist yet are kept so a
+// bank moved between databases keeps its ids. Nothing is written when a row
+// is invalid or on a dry run, and changed groups are left as drafts to be
+// published as usual.
+func (u *QuestionUseCaseImpl) ImportQuestionBank(req *ImportQuestionBankRequest) (*ImportQuestionBankOutput, error) {
+	questionBank := &question.QuestionBank{}
+	rowErrors := make([]question.QuestionBankError, 0)
+
+	var err error
+	switch req.Format {
+	case QuestionBankFormatCSV:
+		var csvErrors []question.QuestionBankError
+		questionBank, csvErrors, err = question.ReadQuestionBankCSV(req.Content)
+		rowErrors = append(rowErrors, csvErrors...)
+	default:
+		err = json.NewDecoder(req.Content).Decode(questionBank)
+	}
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004016004",
+			"Invalid question bank file.",
+			err.Error(),
+		)
+	}
+
+	existGroups, err := u.questionGroupRepo.FindQuestionGroups()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004016001",
+			"Failed to find question groups.",
+			err.Error(),
+		)
+	}
+
+	existChoices, err := u.questionChoiceRepo.FindQuestionChoices()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004016002",
+			"Failed to find choices.",
+			err.Error(),
+		)
+	}
+
+	bank := newQuestionBankIndex(*existGroups, *existChoices)
+	now := u.clock.Now()
+
+	output := &ImportQuestionBankOutput{
+		DryRun: req.DryRun,
+		Errors: rowErrors,
+	}
+	reject := func(row string, err error) {
+		output.Errors = append(output.Errors, question.QuestionBankError{Row: row, Message: err.Error()})
+	}
+
+	questionGroups := make([]question.QuestionGroup, 0)
+	questionChoices := make([]question.QuestionChoice, 0)
+	groupRows := make(map[primitive.ObjectID]string)
+	for i := range questionBank.Groups {
+		group := &questionBank.Groups[i]
+		row := group.Row
+		if row == "" {
+			row = fmt.Sprintf("groups[%d]", i)
+		}
+
+		err := group.Validate()
+		if err == nil && group.Schedule != nil {
+			err = u.scheduleService.Validate(group.Schedule)
+		}
+
+		var questionGroup *question.QuestionGroup
+		created := false
+		if err == nil {
+			questionGroup, created, err = bank.group(group, now)
+		}
+		if err != nil {
+			reject(row, err)
+		}
+
+		groupChanged := created || (questionGroup != nil && !sameQuestionGroup(questionGroup, group))
+		for j := range group.Choices {
+			choice := &group.Choices[j]
+			choiceRow := choice.Row
+			if choiceRow == "" {
+				choiceRow = fmt.Sprintf("groups[%d].choices[%d]", i, j)
+			}
+
+			if err := choice.Validate(); err != nil {
+				reject(choiceRow, err)
+				continue
+			}
+			if questionGroup == nil {
+				continue
+			}
+
+			questionChoice, created, err := bank.choice(questionGroup.ID, choice, now)
+			if err != nil {
+				reject(choiceRow, err)
+				continue
+			}
+
+			switch {
+			case created:
+				output.CreatedChoices++
+			case sameQuestionChoice(questionChoice, choice):
+				output.UnchangedChoices++
+				continue
+			default:
+				output.UpdatedChoices++
+			}
+
+			questionChoice.Question = choice.Question
+			questionChoice.ShouldInvert = choice.ShouldInvert
+			questionChoice.Pinned = choice.Pinned
+			questionChoice.Position = choice.Position
+			questionChoice.Translations = choice.Translations
+			questionChoice.Draft = true
+			questionChoice.UpdatedAt = now
+			questionChoices = append(questionChoices, *questionChoice)
+			groupChanged = true
+		}
+
+		if questionGroup == nil {
+			continue
+		}
+
+		switch {
+		case created:
+			output.CreatedGroups++
+		case groupChanged:
+			output.UpdatedGroups++
+		default:
+			output.UnchangedGroups++
+			continue
+		}
+
+		questionGroup.ColumnName = group.ColumnName
+		questionGroup.Description = group.Description
+		questionGroup.Limit = group.Limit
+		questionGroup.Schedule = group.Schedule
+		questionGroup.Scale = group.Scale
+		questionGroup.Sampling = group.Sampling
+		questionGroup.Conditions = group.Conditions
+		questionGroup.Translations = group.Translations
+		questionGroup.Draft = true
+		questionGroup.UpdatedAt = now
+		questionGroups = append(questionGroups, *questionGroup)
+		groupRows[questionGroup.ID] = row
+	}
+
+	// ✅ Conditions may point at groups and choices later in the same file
+	imported := newImportedQuestionBank(questionGroups, questionChoices)
+	for _, questionGroup := range questionGroups {
+		for k := range questionGroup.Conditions {
+			if err := u.checkImportedCondition(questionGroup.ID, &questionGroup.Conditions[k], imported); err != nil {
+				reject(groupRows[questionGroup.ID], fmt.Errorf("conditions[%d]: %w", k, err))
+			}
+		}
+	}
+
+	if len(output.Errors) > 0 || req.DryRun {
+		return output, nil
+	}
+
+	err = u.questionBankRepo.ImportQuestionBank(questionGroups, questionChoices)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004016003",
+			"Failed to import question bank.",
+			err.Error(),
+		)
+	}
+
+	output.Applied = true
+	return output, nil
+}
+
+// ExportQuestionBank writes the working copy of every group that is not
+// archived, drafts included, in a form ImportQuestionBank reads back.
+func (u *QuestionUseCaseImpl) ExportQuestionBank(req *ExportQuestionBankRequest) (*ExportQuestionBankOutput, error) {
+	questionGroups, err := u.questionGroupRepo.FindQuestionGroups()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004017001",
+			"Failed to find question groups.",
+			err.Error(),
+		)
+	}
+
+	questionChoices, err := u.questionChoiceRepo.FindQuestionChoices()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004017002",
+			"Failed to find choices.",
+			err.Error(),
+		)
+	}
+
+	choicesByGroup := make(map[primitive.ObjectID][]question.QuestionChoice)
+	for _, questionChoice := range *questionChoices {
+		if questionChoice.ArchivedAt != nil {
+			continue
+		}
+		choicesByGroup[questionChoice.QuestionGroup] = append(choicesByGroup[questionChoice.QuestionGroup], questionChoice)
+	}
+
+	bank := question.QuestionBank{
+		Groups: make([]question.QuestionBankGroup, 0, len(*questionGroups)),
+	}
+	for i := range *questionGroups {
+		questionGroup := &(*questionGroups)[i]
+		if questionGroup.ArchivedAt != nil {
+			continue
+		}
+		bank.Groups = append(bank.Groups, question.NewQuestionBankGroup(questionGroup, choicesByGroup[questionGroup.ID]))
+	}
+
+	output := ExportQuestionBankOutput{
+		ContentType: "application/json",
+		FileName:    "question-bank.json",
+	}
+
+	var content bytes.Buffer
+	switch req.Format {
+	case QuestionBankFormatCSV:
+		output.ContentType = "text/csv; charset=utf-8"
+		output.FileName = "question-bank.csv"
+		err = question.WriteQuestionBankCSV(&content, &bank)
+	default:
+		encoder := json.NewEncoder(&content)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(bank)
+	}
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE004017003",
+			"Failed to export question bank.",
+			err.Error(),
+		)
+	}
+
+	output.Content = content.Bytes()
+	return &output, nil
+}
+
+// importedQuestionBank holds the groups and choices an import writes, so
+// conditions can refer to them before they are stored.
+type importedQuestionBank struct {
+	groups       map[primitive.ObjectID]bool
+	choiceGroups map[primitive.ObjectID]primitive.ObjectID
+}
+
+func newImportedQuestionBank(questionGroups []question.QuestionGroup, questionChoices []question.QuestionChoice) *importedQuestionBank {
+	imported := &importedQuestionBank{
+		groups:       make(map[primitive.ObjectID]bool, len(questionGroups)),
+		choiceGroups: make(map[primitive.ObjectID]primitive.ObjectID, len(questionChoices)),
+	}
+
+	for _, questionGroup := range questionGroups {
+		imported.groups[questionGroup.ID] = true
+	}
+	for _, questionChoice := range questionChoices {
+		imported.choiceGroups[questionChoice.ID] = questionChoice.QuestionGroup
+	}
+
+	return imported
+}
+
+// checkImportedCondition applies checkCondition to an imported group,
+// resolving groups and choices of the same import before the stored ones.
+func (u *QuestionUseCaseImpl) checkImportedCondition(questionGroup primitive.ObjectID, condition *question.Condition, imported *importedQuestionBank) error {
+	if err := condition.Validate(); err != nil {
+		return err
+	}
+
+	switch condition.Type {
+	case question.ConditionScore:
+		if imported.groups[*condition.QuestionGroup] {
+			if *condition.QuestionGroup == questionGroup {
+				return fmt.Errorf("a question group can not depend on its own score")
+			}
+			return nil
+		}
+	case question.ConditionAnswer:
+		if owner, ok := imported.choiceGroups[*condition.Choice]; ok {
+			if owner == questionGroup {
+				return fmt.Errorf("a question group can not depend on its own choices")
+			}
+			return nil
+		}
+	}
+
+	return u.checkCondition(questionGroup, condition)
+}
+
+// questionBankIndex resolves import rows against what is already stored and
+// refuses rows that would write the same group or choice twice.
+type questionBankIndex struct {
+	groups            map[primitive.ObjectID]*question.QuestionGroup
+	groupsByName      map[string]*question.QuestionGroup
+	choices           map[primitive.ObjectID]*question.QuestionChoice
+	choicesByQuestion map[primitive.ObjectID]map[string]*question.QuestionChoice
+	imported          map[primitive.ObjectID]bool
+}
+
+func newQuestionBankIndex(questionGroups []question.QuestionGroup, questionChoices []question.QuestionChoice) *questionBankIndex {
+	index := &questionBankIndex{
+		groups:            make(map[primitive.ObjectID]*question.QuestionGroup),
+		groupsByName:      make(map[string]*question.QuestionGroup),
+		choices:           make(map[primitive.ObjectID]*question.QuestionChoice),
+		choicesByQuestion: make(map[primitive.ObjectID]map[string]*question.QuestionChoice),
+		imported:          make(map[primitive.ObjectID]bool),
+	}
+
+	for i := range questionGroups {
+		index.groups[questionGroups[i].ID] = &questionGroups[i]
+		index.groupsByName[questionGroups[i].ColumnName] = &questionGroups[i]
+	}
+
+	for i := range questionChoices {
+		questionChoice := &questionChoices[i]
+		index.choices[questionChoice.ID] = questionChoice
+		if index.choicesByQuestion[questionChoice.QuestionGroup] == nil {
+			index.choicesByQuestion[questionChoice.QuestionGroup] = make(map[string]*question.QuestionChoice)
+		}
+		index.choicesByQuestion[questionChoice.QuestionGroup][questionChoice.Question] = questionChoice
+	}
+
+	return index
+}
+
+func (i *questionBankIndex) group(group *question.QuestionBankGroup, now time.Time) (*question.QuestionGroup, bool, error) {
+	var questionGroup *question.QuestionGroup
+	if group.ID != "" {
+		objectId, err := primitive.ObjectIDFromHex(group.ID)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid question group id %s", group.ID)
+		}
+		questionGroup = i.groups[objectId]
+		if questionGroup == nil {
+			questionGroup = question.NewQuestionGroup(group.ColumnName, group.Description, group.Limit, group.Schedule, group.Scale, group.Sampling, group.Translations)
+			questionGroup.ID = objectId
+		}
+	} else if existGroup, ok := i.groupsByName[group.ColumnName]; ok {
+		questionGroup = existGroup
+	} else {
+		questionGroup = question.NewQuestionGroup(group.ColumnName, group.Description, group.Limit, group.Schedule, group.Scale, group.Sampling, group.Translations)
+	}
+
+	if i.imported[questionGroup.ID] {
+		return nil, false, fmt.Errorf("question group %s is imported twice", questionGroup.ID.Hex())
+	}
+	i.imported[questionGroup.ID] = true
+
+	created := i.groups[questionGroup.ID] == nil
+	if created {
+		questionGroup.CreatedAt = now
+		questionGroup.UpdatedAt = now
+	}
+
+	return questionGroup, created, nil
+}
+
+func (i *questionBankIndex) choice(questionGroup primitive.ObjectID, choice *question.QuestionBankChoice, now time.Time) (*question.QuestionChoice, bool, error) {
+	var questionChoice *question.QuestionChoice
+	if choice.ID != "" {
+		objectId, err := primitive.ObjectIDFromHex(choice.ID)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid question choice id %s", choice.ID)
+		}
+		questionChoice = i.choices[objectId]
+		if questionChoice == nil {
+			questionChoice = question.NewQuestionChoice(questionGroup, choice.Question, choice.ShouldInvert, choice.Pinned, choice.Position, choice.Translations)
+			questionChoice.ID = objectId
+		} else if questionChoice.QuestionGroup != questionGroup {
+			return nil, false, fmt.Errorf("question choice %s belongs to another question group", choice.ID)
+		}
+	} else if existChoice, ok := i.choicesByQuestion[questionGroup][choice.Question]; ok {
+		questionChoice = existChoice
+	} else {
+		questionChoice = question.NewQuestionChoice(questionGroup, choice.Question, choice.ShouldInvert, choice.Pinned, choice.Position, choice.Translations)
+	}
+
+	if i.imported[questionChoice.ID] {
+		return nil, false, fmt.Errorf("question choice %s is imported twice", questionChoice.ID.Hex())
+	}
+	i.imported[questionChoice.ID] = true
+
+	created := i.choices[questionChoice.ID] == nil
+	if created {
+		questionChoice.CreatedAt = now
+		questionChoice.UpdatedAt = now
+	}
+
+	return questionChoice, created, nil
+}
+
+func sameQuestionGroup(questionGroup *question.QuestionGroup, group *question.QuestionBankGroup) bool {
+	return questionGroup.ColumnName == group.ColumnName &&
+		questionGroup.Description == group.Description &&
+		questionGroup.Limit == group.Limit &&
+		reflect.DeepEqual(questionGroup.Schedule, group.Schedule) &&
+		reflect.DeepEqual(questionGroup.Scale, group.Scale) &&
+		reflect.DeepEqual(questionGroup.Sampling, group.Sampling) &&
+		(len(questionGroup.Conditions) == 0 && len(group.Conditions) == 0 || reflect.DeepEqual(questionGroup.Conditions, group.Conditions)) &&
+		sameTranslations(questionGroup.Translations, group.Translations)
+}
+
+func sameQuestionChoice(questionChoice *question.QuestionChoice, choice *question.QuestionBankChoice) bool {
+	return questionChoice.Question == choice.Question &&
+		questionChoice.ShouldInvert == choice.ShouldInvert &&
+		questionChoice.Pinned == choice.Pinned &&
+		questionChoice.Position == choice.Position &&
+		sameTranslations(questionChoice.Translations, choice.Translations)
+}
+
+func sameTranslations[T any](a, b map[string]T) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
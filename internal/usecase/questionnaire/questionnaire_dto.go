@@ -0,0 +1,94 @@
+package questionnaire
+
+import (
+	"mucb_be/internal/domain/question"
+	"mucb_be/internal/domain/questionnaire"
+	"mucb_be/internal/domain/schedule"
+)
+
+type CreateQuestionnaireRequest struct {
+	Name           string                `json:"name" binding:"required,max=64"`
+	Description    string                `json:"description" binding:"max=256"`
+	QuestionGroups []string              `json:"questionGroups" binding:"required,min=1,max=20,dive,len=24"`
+	Schedule       *schedule.Schedule    `json:"schedule,omitempty" binding:"omitempty"`
+	Scoring        questionnaire.Scoring `json:"scoring" binding:"required"`
+	Active         bool                  `json:"active"`
+}
+
+type GetQuestionnairesRequest struct {
+	Page  int `json:"page" binding:"required,min=1"`
+	Limit int `json:"limit" binding:"required,min=1,max=50"`
+}
+
+type GetQuestionnairesOutput struct {
+	Total int                            `json:"total"`
+	Page  int                            `json:"page"`
+	Items *[]questionnaire.Questionnaire `json:"items"`
+}
+
+type UpdateQuestionnaireRequest struct {
+	Questionnaire  string                `json:"questionnaire" binding:"required"`
+	Name           string                `json:"name" binding:"required,max=64"`
+	Description    string                `json:"description" binding:"max=256"`
+	QuestionGroups []string              `json:"questionGroups" binding:"required,min=1,max=20,dive,len=24"`
+	Schedule       *schedule.Schedule    `json:"schedule,omitempty" binding:"omitempty"`
+	Scoring        questionnaire.Scoring `json:"scoring" binding:"required"`
+	Active         bool                  `json:"active"`
+}
+
+type RemoveQuestionnaireRequest struct {
+	Questionnaire string `json:"questionnaire" binding:"required"`
+}
+
+type AvailableQuestionnaire struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	GroupSize   int    `json:"groupSize"`
+	schedule.Availability
+}
+
+type GetAvailableQuestionnairesOutput struct {
+	Items []AvailableQuestionnaire `json:"items"`
+}
+
+type GetQuestionnaireExamRequest struct {
+	Questionnaire  string
+	AcceptLanguage string
+}
+
+type ExamStepValue struct {
+	Choice string `json:"choice" binding:"required,len=24"`
+	Value  *int   `json:"value" binding:"required"`
+}
+
+// ExamStepAnswer is one group answered so far, in the same shape as a
+// submitted group answer.
+type ExamStepAnswer struct {
+	QuestionGroup string          `json:"questionGroup" binding:"required,len=24"`
+	Values        []ExamStepValue `json:"values,omitempty" binding:"omitempty,max=20,dive"`
+	Score         int             `json:"score" binding:"required_without=Values"`
+	QuestionSize  int             `json:"questionSize" binding:"required_without=Values"`
+}
+
+type NextQuestionnaireStepRequest struct {
+	Questionnaire  string           `json:"-"`
+	AcceptLanguage string           `json:"-"`
+	Answers        []ExamStepAnswer `json:"answers" binding:"max=20,dive"`
+}
+
+type NextQuestionnaireStepOutput struct {
+	ID      string                            `json:"id"`
+	Step    int                               `json:"step"`
+	Done    bool                              `json:"done"`
+	Item    *question.GroupsWithRandomChoices `json:"item,omitempty"`
+	Skipped []string                          `json:"skipped"`
+	Result  *questionnaire.Result             `json:"result,omitempty"`
+}
+
+type GetQuestionnaireExamOutput struct {
+	ID          string                              `json:"id"`
+	Name        string                              `json:"name"`
+	Description string                              `json:"description"`
+	Items       *[]question.GroupsWithRandomChoices `json:"items"`
+}
@@ -0,0 +1,17 @@
+package questionnaire
+
+import (
+	"mucb_be/internal/domain/questionnaire"
+	"mucb_be/internal/infrastructure/security"
+)
+
+type QuestionnaireInterface interface {
+	CreateQuestionnaire(req *CreateQuestionnaireRequest) (string, error)
+	FindAllQuestionnaire(req *GetQuestionnairesRequest) (*GetQuestionnairesOutput, error)
+	GetQuestionnaireById(id string) (*questionnaire.Questionnaire, error)
+	UpdateQuestionnaire(req *UpdateQuestionnaireRequest) error
+	RemoveQuestionnaire(req *RemoveQuestionnaireRequest) error
+	FindAvailableQuestionnaires(claims *security.AccessTokenModel) (*GetAvailableQuestionnairesOutput, error)
+	NextQuestionnaireStep(req *NextQuestionnaireStepRequest, claims *security.AccessTokenModel) (*NextQuestionnaireStepOutput, error)
+	GetQuestionnaireExam(req *GetQuestionnaireExamRequest, claims *security.AccessTokenModel) (*GetQuestionnaireExamOutput, error)
+}
@@ -0,0 +1,527 @@
+package questionnaire
+
+import (
+	goerrors "errors"
+	"mucb_be/internal/domain/locale"
+	"mucb_be/internal/domain/question"
+	"mucb_be/internal/domain/questionnaire"
+	"mucb_be/internal/domain/user"
+	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/clock"
+	"mucb_be/internal/infrastructure/sampling"
+	"mucb_be/internal/infrastructure/scheduling"
+	"mucb_be/internal/infrastructure/security"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type QuestionnaireUseCaseImpl struct {
+	questionnaireRepo    questionnaire.QuestionnaireRepository
+	questionGroupRepo    question.QuestionGroupRepository
+	questionRevisionRepo question.QuestionRevisionRepository
+	scheduleService      scheduling.ScheduleServiceInterface
+	availabilityService  scheduling.AvailabilityServiceInterface
+	choiceSampler        sampling.ChoiceSamplerInterface
+	userRepo             user.UserRepository
+	clock                clock.ClockInterface
+}
+
+func NewQuestionnaireUseCase(
+	questionnaireRepo questionnaire.QuestionnaireRepository,
+	questionGroupRepo question.QuestionGroupRepository,
+	questionRevisionRepo question.QuestionRevisionRepository,
+	scheduleService scheduling.ScheduleServiceInterface,
+	availabilityService scheduling.AvailabilityServiceInterface,
+	choiceSampler sampling.ChoiceSamplerInterface,
+	userRepo user.UserRepository,
+	clock clock.ClockInterface,
+) QuestionnaireInterface {
+	return &QuestionnaireUseCaseImpl{
+		questionnaireRepo:    questionnaireRepo,
+		questionGroupRepo:    questionGroupRepo,
+		questionRevisionRepo: questionRevisionRepo,
+		scheduleService:      scheduleService,
+		availabilityService:  availabilityService,
+		choiceSampler:        choiceSampler,
+		userRepo:             userRepo,
+		clock:                clock,
+	}
+}
+
+func (u *QuestionnaireUseCaseImpl) CreateQuestionnaire(req *CreateQuestionnaireRequest) (string, error) {
+	if req.Schedule != nil {
+		err := u.scheduleService.Validate(req.Schedule)
+		if err != nil {
+			return "", errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013001001",
+				"Invalid schedule.",
+				err.Error(),
+			)
+		}
+	}
+
+	questionGroups, err := u.questionGroupIds(req.QuestionGroups)
+	if err != nil {
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013001002",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	newQuestionnaire := questionnaire.NewQuestionnaire(
+		req.Name,
+		req.Description,
+		questionGroups,
+		req.Schedule,
+		req.Scoring,
+		req.Active,
+	)
+
+	err = u.questionnaireRepo.CreateQuestionnaire(newQuestionnaire)
+	if err != nil {
+		if err.Error() == "duplicate questionnaire name" {
+			return "", errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013001003",
+				"Questionnaire name duplicated.",
+				err.Error(),
+			)
+		}
+
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013001004",
+			"Failed to insert questionnaire.",
+			err.Error(),
+		)
+	}
+
+	return newQuestionnaire.ID.Hex(), nil
+}
+
+func (u *QuestionnaireUseCaseImpl) FindAllQuestionnaire(req *GetQuestionnairesRequest) (*GetQuestionnairesOutput, error) {
+	questionnaires, total, err := u.questionnaireRepo.FindAllQuestionnaire(req.Page, req.Limit)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013002001",
+			"Failed to get questionnaires.",
+			err.Error(),
+		)
+	}
+
+	return &GetQuestionnairesOutput{
+		Total: total,
+		Page:  req.Page,
+		Items: questionnaires,
+	}, nil
+}
+
+func (u *QuestionnaireUseCaseImpl) GetQuestionnaireById(id string) (*questionnaire.Questionnaire, error) {
+	existQuestionnaire, err := u.questionnaireRepo.FindQuestionnaireById(id)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013003001",
+			"Questionnaire not found.",
+			err.Error(),
+		)
+	}
+
+	return existQuestionnaire, nil
+}
+
+func (u *QuestionnaireUseCaseImpl) UpdateQuestionnaire(req *UpdateQuestionnaireRequest) error {
+	if req.Schedule != nil {
+		err := u.scheduleService.Validate(req.Schedule)
+		if err != nil {
+			return errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013004001",
+				"Invalid schedule.",
+				err.Error(),
+			)
+		}
+	}
+
+	questionGroups, err := u.questionGroupIds(req.QuestionGroups)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013004002",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	err = u.questionnaireRepo.UpdateQuestionnaireById(
+		req.Questionnaire,
+		req.Name,
+		req.Description,
+		questionGroups,
+		req.Schedule,
+		req.Scoring,
+		req.Active,
+	)
+	if err != nil {
+		if err.Error() == "duplicate questionnaire name" {
+			return errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013004003",
+				"Questionnaire name duplicated.",
+				err.Error(),
+			)
+		}
+
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013004004",
+			"Failed to update questionnaire.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+func (u *QuestionnaireUseCaseImpl) RemoveQuestionnaire(req *RemoveQuestionnaireRequest) error {
+	err := u.questionnaireRepo.RemoveQuestionnaireById(req.Questionnaire)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013005001",
+			"Failed to remove questionnaire.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+// FindAvailableQuestionnaires lists the active questionnaires together with
+// whether the caller can answer each of them right now.
+func (u *QuestionnaireUseCaseImpl) FindAvailableQuestionnaires(claims *security.AccessTokenModel) (*GetAvailableQuestionnairesOutput, error) {
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013006001",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	questionnaires, err := u.questionnaireRepo.FindActiveQuestionnaires()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013006002",
+			"Failed to get questionnaires.",
+			err.Error(),
+		)
+	}
+
+	now := u.clock.Now()
+	items := make([]AvailableQuestionnaire, 0, len(*questionnaires))
+	for _, activeQuestionnaire := range *questionnaires {
+		availability, err := u.availabilityService.QuestionnaireAvailability(existUser.ID, &activeQuestionnaire, now, existUser.Location())
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013006003",
+				"Failed to check submission status.",
+				err.Error(),
+			)
+		}
+
+		items = append(items, AvailableQuestionnaire{
+			ID:           activeQuestionnaire.ID.Hex(),
+			Name:         activeQuestionnaire.Name,
+			Description:  activeQuestionnaire.Description,
+			GroupSize:    len(activeQuestionnaire.QuestionGroups),
+			Availability: *availability,
+		})
+	}
+
+	return &GetAvailableQuestionnairesOutput{
+		Items: items,
+	}, nil
+}
+
+// GetQuestionnaireExam renders the live revision of every group in the
+// questionnaire, in the order the questionnaire defines.
+func (u *QuestionnaireUseCaseImpl) GetQuestionnaireExam(req *GetQuestionnaireExamRequest, claims *security.AccessTokenModel) (*GetQuestionnaireExamOutput, error) {
+	existQuestionnaire, err := u.questionnaireRepo.FindQuestionnaireById(req.Questionnaire)
+	if err != nil || !existQuestionnaire.Active {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013007001",
+			"Questionnaire not found.",
+			"",
+		)
+	}
+
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013007002",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	now := u.clock.Now()
+	availability, err := u.availabilityService.QuestionnaireAvailability(existUser.ID, existQuestionnaire, now, existUser.Location())
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013007003",
+			"Failed to check submission status.",
+			err.Error(),
+		)
+	}
+
+	if !availability.Available {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013007004",
+			"This questionnaire is not available right now.",
+			"",
+		)
+	}
+
+	questionRevisions, err := u.questionRevisionRepo.FindLiveQuestionRevisions(now)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013007005",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	liveRevisions := make(map[primitive.ObjectID]*question.QuestionRevision)
+	for i := range *questionRevisions {
+		liveRevisions[(*questionRevisions)[i].QuestionGroup] = &(*questionRevisions)[i]
+	}
+
+	name := locale.Resolve(req.AcceptLanguage, existUser.Language)
+	state := question.NewBranchState(question.NewProfile(existUser.GroupCode, existUser.Language))
+
+	// ✅ Groups that have never been published are left out, and so are groups
+	// whose conditions need answers; NextQuestionnaireStep serves those
+	items := make([]question.GroupsWithRandomChoices, 0, len(existQuestionnaire.QuestionGroups))
+	for _, questionGroup := range existQuestionnaire.QuestionGroups {
+		liveRevision, ok := liveRevisions[questionGroup]
+		if !ok || !liveRevision.Eligible(state) {
+			continue
+		}
+
+		item, err := u.choiceSampler.Sample(liveRevision, existUser, now)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013007006",
+				"Failed to draw choices.",
+				err.Error(),
+			)
+		}
+		item.Localize(name)
+		items = append(items, *item)
+	}
+
+	return &GetQuestionnaireExamOutput{
+		ID:          existQuestionnaire.ID.Hex(),
+		Name:        existQuestionnaire.Name,
+		Description: existQuestionnaire.Description,
+		Items:       &items,
+	}, nil
+}
+
+// NextQuestionnaireStep replays the answers given so far and returns the next
+// group to show, so conditions on scores, answers and the participant's
+// profile are evaluated by the server one page at a time. When nothing is left
+// the step is done and carries the result the submission would get.
+func (u *QuestionnaireUseCaseImpl) NextQuestionnaireStep(req *NextQuestionnaireStepRequest, claims *security.AccessTokenModel) (*NextQuestionnaireStepOutput, error) {
+	existQuestionnaire, err := u.questionnaireRepo.FindQuestionnaireById(req.Questionnaire)
+	if err != nil || !existQuestionnaire.Active {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013008001",
+			"Questionnaire not found.",
+			"",
+		)
+	}
+
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013008002",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	now := u.clock.Now()
+	availability, err := u.availabilityService.QuestionnaireAvailability(existUser.ID, existQuestionnaire, now, existUser.Location())
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013008003",
+			"Failed to check submission status.",
+			err.Error(),
+		)
+	}
+
+	if !availability.Available {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013008004",
+			"This questionnaire is not available right now.",
+			"",
+		)
+	}
+
+	questionRevisions, err := u.questionRevisionRepo.FindLiveQuestionRevisions(now)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013008005",
+			"Question group not found.",
+			err.Error(),
+		)
+	}
+
+	liveRevisions := make(map[primitive.ObjectID]*question.QuestionRevision)
+	for i := range *questionRevisions {
+		liveRevisions[(*questionRevisions)[i].QuestionGroup] = &(*questionRevisions)[i]
+	}
+
+	state := question.NewBranchState(question.NewProfile(existUser.GroupCode, existUser.Language))
+	answered := make(map[primitive.ObjectID]bool)
+	groupScores := make([]int, 0, len(req.Answers))
+	for _, answer := range req.Answers {
+		questionGroup, err := primitive.ObjectIDFromHex(answer.QuestionGroup)
+		liveRevision, ok := liveRevisions[questionGroup]
+		if err != nil || !ok || !existQuestionnaire.Contains(questionGroup) || answered[questionGroup] {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013008006",
+				"Question group is not part of this questionnaire.",
+				answer.QuestionGroup,
+			)
+		}
+
+		values, err := choiceAnswers(answer.Values)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013008007",
+				"Answer does not match the question scale.",
+				err.Error(),
+			)
+		}
+
+		score, _, err := liveRevision.Answer(values, answer.Score, answer.QuestionSize)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013008007",
+				"Answer does not match the question scale.",
+				err.Error(),
+			)
+		}
+
+		answered[questionGroup] = true
+		groupScores = append(groupScores, score)
+		state.Answer(questionGroup, score, values)
+	}
+
+	for questionGroup := range answered {
+		if !liveRevisions[questionGroup].Eligible(state) {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE013008008",
+				"Question group was skipped by its conditions.",
+				questionGroup.Hex(),
+			)
+		}
+	}
+
+	next, skipped := existQuestionnaire.NextGroup(liveRevisions, answered, state)
+
+	output := NextQuestionnaireStepOutput{
+		ID:      existQuestionnaire.ID.Hex(),
+		Step:    len(answered) + 1,
+		Skipped: make([]string, 0, len(skipped)),
+	}
+	for _, questionGroup := range skipped {
+		output.Skipped = append(output.Skipped, questionGroup.Hex())
+	}
+
+	if next == nil {
+		output.Done = true
+		output.Step = len(answered)
+		output.Result = existQuestionnaire.Score(groupScores)
+		return &output, nil
+	}
+
+	item, err := u.choiceSampler.Sample(next, existUser, now)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE013008009",
+			"Failed to draw choices.",
+			err.Error(),
+		)
+	}
+	item.Localize(locale.Resolve(req.AcceptLanguage, existUser.Language))
+	output.Item = item
+
+	return &output, nil
+}
+
+func choiceAnswers(values []ExamStepValue) ([]question.ChoiceAnswer, error) {
+	choiceAnswers := make([]question.ChoiceAnswer, 0, len(values))
+	for _, value := range values {
+		choice, err := primitive.ObjectIDFromHex(value.Choice)
+		if err != nil {
+			return nil, err
+		}
+		choiceAnswers = append(choiceAnswers, question.ChoiceAnswer{
+			Choice: choice,
+			Value:  *value.Value,
+		})
+	}
+	return choiceAnswers, nil
+}
+
+// questionGroupIds keeps the order given by the admin and checks that every
+// group exists and is not archived.
+func (u *QuestionnaireUseCaseImpl) questionGroupIds(ids []string) ([]primitive.ObjectID, error) {
+	questionGroups := make([]primitive.ObjectID, 0, len(ids))
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			return nil, goerrors.New("duplicate question group " + id)
+		}
+		seen[id] = true
+
+		existQuestionGroup, err := u.questionGroupRepo.FindQuestionGroupById(id)
+		if err != nil {
+			return nil, err
+		}
+		if existQuestionGroup.ArchivedAt != nil {
+			return nil, goerrors.New("question group " + id + " is archived")
+		}
+		questionGroups = append(questionGroups, existQuestionGroup.ID)
+	}
+
+	return questionGroups, nil
+}
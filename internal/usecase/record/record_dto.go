@@ -1,26 +1,141 @@
 package record
 
+import (
+	"mucb_be/internal/domain/questionnaire"
+	"mucb_be/internal/domain/risk"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ChoiceAnswerValue struct {
+	Choice string `json:"choice" binding:"required,len=24"`
+	Value  *int   `json:"value" binding:"required"`
+}
+
+// GroupRecordAnswer carries either the raw value picked for every choice,
+// which the server validates and scores, or the score computed by older
+// clients.
 type GroupRecordAnswer struct {
-	QuestionGroup string `json:"questionGroup" binding:"required"`
-	Score         int    `json:"score" binding:"required"`
-	QuestionSize  int    `json:"questionSize" binding:"required"`
+	QuestionGroup string              `json:"questionGroup" binding:"required"`
+	Revision      string              `json:"revision,omitempty" binding:"omitempty,len=24"`
+	Values        []ChoiceAnswerValue `json:"values,omitempty" binding:"omitempty,max=20,dive"`
+	Score         int                 `json:"score" binding:"required_without=Values"`
+	QuestionSize  int                 `json:"questionSize" binding:"required_without=Values"`
 }
 
 type CardRecordAnswer struct {
 	Card string `json:"card" binding:"required"`
 }
 
+const (
+	CardAnswerInvalidId = "INVALID_ID"
+	CardAnswerNotFound  = "NOT_FOUND"
+	CardAnswerInactive  = "INACTIVE"
+	CardAnswerArchived  = "ARCHIVED"
+	CardAnswerDuplicate = "DUPLICATE"
+)
+
+// CardAnswerError tells the client which submitted card was rejected and why.
+type CardAnswerError struct {
+	Index  int    `json:"index"`
+	Card   string `json:"card"`
+	Reason string `json:"reason"`
+}
+
 type CreateGroupRecordRequest struct {
 	GroupCode *string             `json:"groupCode,omitempty" binding:"omitempty,max=64"`
 	Answers   []GroupRecordAnswer `json:"answers" binding:"required,dive"`
 }
 
+type CreateQuestionnaireRecordRequest struct {
+	Questionnaire string              `json:"questionnaire" binding:"required,len=24"`
+	GroupCode     *string             `json:"groupCode,omitempty" binding:"omitempty,max=64"`
+	Answers       []GroupRecordAnswer `json:"answers" binding:"required,min=1,dive"`
+}
+
 type CreateManyCardRequest struct {
 	GroupCode *string            `json:"groupCode,omitempty" binding:"omitempty,max=64"`
-	Answers   []CardRecordAnswer `json:"answers" binding:"required,min=1,max=5,dive,required"`
+	Answers   []CardRecordAnswer `json:"answers" binding:"required,min=1,max=100,dive,required"`
 }
 
 type CreateStoryRequest struct {
 	GroupCode *string `json:"groupCode,omitempty" binding:"omitempty,max=64"`
 	Content   string  `json:"content" binding:"required,max=4048"`
 }
+
+type CreateStoryOutput struct {
+	Support *risk.SupportContent `json:"support,omitempty"`
+}
+
+type SyncSubmissionItem struct {
+	Type          string              `json:"type" binding:"required,oneof=GROUP CARD STORY QUESTIONNAIRE"`
+	SubmittedAt   time.Time           `json:"submittedAt" binding:"required"`
+	Timezone      string              `json:"timezone" binding:"required,max=64"`
+	GroupCode     *string             `json:"groupCode,omitempty" binding:"omitempty,max=64"`
+	Questionnaire string              `json:"questionnaire,omitempty" binding:"required_if=Type QUESTIONNAIRE,omitempty,len=24"`
+	GroupAnswers  []GroupRecordAnswer `json:"groupAnswers,omitempty" binding:"required_if=Type GROUP,required_if=Type QUESTIONNAIRE,dive"`
+	CardAnswers   []CardRecordAnswer  `json:"cardAnswers,omitempty" binding:"required_if=Type CARD,max=100,dive,required"`
+	Content       string              `json:"content,omitempty" binding:"required_if=Type STORY,max=4048"`
+}
+
+type SyncSubmissionRequest struct {
+	Submissions []SyncSubmissionItem `json:"submissions" binding:"required,min=1,max=50,dive"`
+}
+
+type SyncSubmissionResult struct {
+	Index     int                   `json:"index"`
+	Type      string                `json:"type"`
+	Accepted  bool                  `json:"accepted"`
+	LocalDate string                `json:"localDate,omitempty"`
+	Code      string                `json:"code,omitempty"`
+	Message   string                `json:"message,omitempty"`
+	Details   interface{}           `json:"details,omitempty"`
+	Support   *risk.SupportContent  `json:"support,omitempty"`
+	Result    *questionnaire.Result `json:"result,omitempty"`
+}
+
+type SyncSubmissionOutput struct {
+	Results []SyncSubmissionResult `json:"results"`
+}
+
+type GetHistoryRequest struct {
+	Days int `json:"days" binding:"required,min=1,max=90"`
+}
+
+type DailyHistory struct {
+	Date         string `json:"date"`
+	GroupRecords int    `json:"groupRecords"`
+	CardRecords  int    `json:"cardRecords"`
+	StoryRecords int    `json:"storyRecords"`
+}
+
+type GetHistoryOutput struct {
+	Timezone string         `json:"timezone"`
+	Streak   int            `json:"streak"`
+	Days     []DailyHistory `json:"days"`
+}
+
+type GetCardSummaryRequest struct {
+	Month          *string
+	AcceptLanguage string
+}
+
+type CardSummaryItem struct {
+	Card  primitive.ObjectID `json:"card"`
+	Name  string             `json:"name"`
+	Count int                `json:"count"`
+}
+
+type DailyCardSummary struct {
+	Date  string            `json:"date"`
+	Cards []CardSummaryItem `json:"cards"`
+}
+
+type GetCardSummaryOutput struct {
+	Month    string             `json:"month"`
+	Timezone string             `json:"timezone"`
+	Total    int                `json:"total"`
+	Cards    []CardSummaryItem  `json:"cards"`
+	Days     []DailyCardSummary `json:"days"`
+}
@@ -1,11 +1,16 @@
 package record
 
 import (
+	"mucb_be/internal/domain/questionnaire"
 	"mucb_be/internal/infrastructure/security"
 )
 
 type RecordInterface interface {
 	CreateManyGroupRecord(req *CreateGroupRecordRequest, claims *security.AccessTokenModel) error
+	CreateQuestionnaireRecord(req *CreateQuestionnaireRecordRequest, claims *security.AccessTokenModel) (*questionnaire.Result, error)
 	CreateManyCardRecord(req *CreateManyCardRequest, claims *security.AccessTokenModel) error
-	CreateStoryRecord(req *CreateStoryRequest, claims *security.AccessTokenModel) error
+	CreateStoryRecord(req *CreateStoryRequest, claims *security.AccessTokenModel) (*CreateStoryOutput, error)
+	SyncSubmissions(req *SyncSubmissionRequest, claims *security.AccessTokenModel) (*SyncSubmissionOutput, error)
+	GetHistory(req *GetHistoryRequest, claims *security.AccessTokenModel) (*GetHistoryOutput, error)
+	GetCardSummary(req *GetCardSummaryRequest, claims *security.AccessTokenModel) (*GetCardSummaryOutput, error)
 }
@@ -1,37 +1,110 @@
 package record
 
 import (
+	goerrors "errors"
+	"fmt"
+	"log"
+	"mucb_be/internal/config"
+	"mucb_be/internal/domain/admin"
+	"mucb_be/internal/domain/card"
+	"mucb_be/internal/domain/locale"
+	"mucb_be/internal/domain/question"
+	"mucb_be/internal/domain/questionnaire"
 	"mucb_be/internal/domain/record"
+	"mucb_be/internal/domain/risk"
+	"mucb_be/internal/domain/user"
 	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/clock"
+	"mucb_be/internal/infrastructure/notification"
+	"mucb_be/internal/infrastructure/scheduling"
+	"mucb_be/internal/infrastructure/screening"
 	"mucb_be/internal/infrastructure/security"
 	"net/http"
+	"sort"
+	"strconv"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	defaultSyncMaxPastHour     = 72
+	defaultSyncMaxFutureMinute = 10
+	syncMaxZoneDriftHour       = 3
+	streakLookbackDays         = 365
+	summaryMonthLayout         = "2006-01"
+)
+
+var (
+	errInvalidQuestionGroup = goerrors.New("invalid question group")
+	errInvalidCard          = goerrors.New("invalid card")
+	errSelectionLimit       = goerrors.New("too many cards picked")
+	errWindowClosed         = goerrors.New("no submission window is open")
+	errRevisionMismatch     = goerrors.New("revision does not belong to the question group")
+	errNotPublished         = goerrors.New("question group is not published")
+	errArchived             = goerrors.New("question group is archived")
+	errInvalidQuestionnaire = goerrors.New("invalid questionnaire")
+	errIncomplete           = goerrors.New("questionnaire is not complete")
+)
+
 type RecordUseCaseImpl struct {
-	groupRecordRepo record.GroupRecordRepository
-	cardRecordRepo  record.CardRecordRepository
-	storyRecordRepo record.StoryRecordRepository
+	cfg                  *config.Config
+	groupRecordRepo      record.GroupRecordRepository
+	cardRecordRepo       record.CardRecordRepository
+	storyRecordRepo      record.StoryRecordRepository
+	submissionSlotRepo   record.SubmissionSlotRepository
+	questionGroupRepo    question.QuestionGroupRepository
+	questionRevisionRepo question.QuestionRevisionRepository
+	questionnaireRepo    questionnaire.QuestionnaireRepository
+	cardRepo             card.CardRepository
+	cardCategoryRepo     card.CardCategoryRepository
+	userRepo             user.UserRepository
+	adminRepo            admin.AdminRepository
+	availabilityService  scheduling.AvailabilityServiceInterface
+	riskDetectionService screening.RiskDetectionServiceInterface
+	riskNotifier         notification.RiskNotifierInterface
+	clock                clock.ClockInterface
 }
 
 func NewRecordUseCase(
+	cfg *config.Config,
 	groupRecordRepo record.GroupRecordRepository,
 	cardRecordRepo record.CardRecordRepository,
 	storyRecordRepo record.StoryRecordRepository,
+	submissionSlotRepo record.SubmissionSlotRepository,
+	questionGroupRepo question.QuestionGroupRepository,
+	questionRevisionRepo question.QuestionRevisionRepository,
+	questionnaireRepo questionnaire.QuestionnaireRepository,
+	cardRepo card.CardRepository,
+	cardCategoryRepo card.CardCategoryRepository,
+	userRepo user.UserRepository,
+	adminRepo admin.AdminRepository,
+	availabilityService scheduling.AvailabilityServiceInterface,
+	riskDetectionService screening.RiskDetectionServiceInterface,
+	riskNotifier notification.RiskNotifierInterface,
+	clock clock.ClockInterface,
 ) RecordInterface {
 	return &RecordUseCaseImpl{
-		groupRecordRepo: groupRecordRepo,
-		cardRecordRepo:  cardRecordRepo,
-		storyRecordRepo: storyRecordRepo,
+		cfg:                  cfg,
+		groupRecordRepo:      groupRecordRepo,
+		cardRecordRepo:       cardRecordRepo,
+		storyRecordRepo:      storyRecordRepo,
+		submissionSlotRepo:   submissionSlotRepo,
+		questionGroupRepo:    questionGroupRepo,
+		questionRevisionRepo: questionRevisionRepo,
+		questionnaireRepo:    questionnaireRepo,
+		cardRepo:             cardRepo,
+		cardCategoryRepo:     cardCategoryRepo,
+		userRepo:             userRepo,
+		adminRepo:            adminRepo,
+		availabilityService:  availabilityService,
+		riskDetectionService: riskDetectionService,
+		riskNotifier:         riskNotifier,
+		clock:                clock,
 	}
 }
 
 func (u *RecordUseCaseImpl) CreateManyGroupRecord(req *CreateGroupRecordRequest, claims *security.AccessTokenModel) error {
-	var groupRecordList []record.GroupRecord
-	var timestamp = time.Now()
-
 	userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
 	if err != nil {
 		return errors.NewCustomError(
@@ -42,57 +115,164 @@ func (u *RecordUseCaseImpl) CreateManyGroupRecord(req *CreateGroupRecordRequest,
 		)
 	}
 
-	isAlreadySubmit, err := u.groupRecordRepo.HasSubmittedToday(userObjectId)
+	existUser, err := u.userRepo.FindUserById(claims.ID)
 	if err != nil {
 		return errors.NewCustomError(
 			http.StatusBadRequest,
-			"UCE005001004",
-			"Failed to check submission status.",
+			"UCE005001007",
+			"User not found.",
 			err.Error(),
 		)
 	}
 
-	if isAlreadySubmit {
+	groupRecordList, submissionSlotList, err := u.prepareGroupRecords(userObjectId, req.GroupCode, req.Answers, u.clock.Now(), existUser.Location())
+	if err == nil {
+		err = u.saveRecords(&submissionSlotList, func() error {
+			return u.groupRecordRepo.CreateManyGroupRecord(&groupRecordList)
+		})
+	}
+
+	switch {
+	case err == nil:
+		return nil
+	case goerrors.Is(err, errInvalidQuestionGroup):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005001002",
+			"Failed to check question group.",
+			err.Error(),
+		)
+	case goerrors.Is(err, question.ErrInvalidAnswer):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005001008",
+			"Answer does not match the question scale.",
+			err.Error(),
+		)
+	case goerrors.Is(err, question.ErrConditionNotMet):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005001009",
+			"Question group was skipped by its conditions.",
+			err.Error(),
+		)
+	case goerrors.Is(err, record.ErrAlreadySubmitted):
 		return errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE005001005",
-			"You can only submit once per day",
-			"",
+			"You have already submitted for the current period.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errWindowClosed):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005001006",
+			"This questionnaire is not available right now.",
+			err.Error(),
+		)
+	default:
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005001003",
+			"Failed to insert records.",
+			err.Error(),
 		)
 	}
+}
 
-	for _, answer := range req.Answers {
-		questionGroupObjectId, err := primitive.ObjectIDFromHex(answer.QuestionGroup)
-		if err != nil {
-			return errors.NewCustomError(
-				http.StatusBadRequest,
-				"UCE005001002",
-				"Failed to check question group.",
-				err.Error(),
-			)
-		}
-
-		groupRecord := record.NewGroupRecord(req.GroupCode, userObjectId, questionGroupObjectId, answer.Score, answer.QuestionSize, timestamp)
-		groupRecordList = append(groupRecordList, *groupRecord)
+// CreateQuestionnaireRecord stores one submission of a questionnaire. The
+// questionnaire schedule decides availability instead of the group schedules.
+func (u *RecordUseCaseImpl) CreateQuestionnaireRecord(req *CreateQuestionnaireRecordRequest, claims *security.AccessTokenModel) (*questionnaire.Result, error) {
+	userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005009001",
+			"Failed to check user.",
+			err.Error(),
+		)
 	}
 
-	err = u.groupRecordRepo.CreateManyGroupRecord((&groupRecordList))
+	existUser, err := u.userRepo.FindUserById(claims.ID)
 	if err != nil {
-		return errors.NewCustomError(
+		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
-			"UCE005001003",
-			"Failed to insert records.",
+			"UCE005009002",
+			"User not found.",
 			err.Error(),
 		)
 	}
 
-	return nil
+	groupRecordList, submissionSlot, result, err := u.prepareQuestionnaireRecords(userObjectId, req.Questionnaire, req.GroupCode, req.Answers, u.clock.Now(), existUser.Location())
+	if err == nil {
+		err = u.saveRecords(&[]record.SubmissionSlot{*submissionSlot}, func() error {
+			return u.groupRecordRepo.CreateManyGroupRecord(&groupRecordList)
+		})
+	}
+
+	switch {
+	case err == nil:
+		return result, nil
+	case goerrors.Is(err, errInvalidQuestionnaire):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005009003",
+			"Questionnaire not found.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errInvalidQuestionGroup):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005009004",
+			"Failed to check question group.",
+			err.Error(),
+		)
+	case goerrors.Is(err, question.ErrInvalidAnswer):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005009008",
+			"Answer does not match the question scale.",
+			err.Error(),
+		)
+	case goerrors.Is(err, question.ErrConditionNotMet):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005009009",
+			"Question group was skipped by its conditions.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errIncomplete):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005009010",
+			"Every question group of the questionnaire must be answered.",
+			err.Error(),
+		)
+	case goerrors.Is(err, record.ErrAlreadySubmitted):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005009005",
+			"You have already submitted for the current period.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errWindowClosed):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005009006",
+			"This questionnaire is not available right now.",
+			err.Error(),
+		)
+	default:
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005009007",
+			"Failed to insert records.",
+			err.Error(),
+		)
+	}
 }
 
 func (u *RecordUseCaseImpl) CreateManyCardRecord(req *CreateManyCardRequest, claims *security.AccessTokenModel) error {
-	var cardRecordList []record.CardRecord
-	var timestamp = time.Now()
-
 	userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
 	if err != nil {
 		return errors.NewCustomError(
@@ -103,75 +283,1054 @@ func (u *RecordUseCaseImpl) CreateManyCardRecord(req *CreateManyCardRequest, cla
 		)
 	}
 
-	isAlreadySubmit, err := u.cardRecordRepo.HasSubmittedToday(userObjectId)
+	existUser, err := u.userRepo.FindUserById(claims.ID)
 	if err != nil {
 		return errors.NewCustomError(
 			http.StatusBadRequest,
-			"UCE005002004",
-			"Failed to check submission status.",
+			"UCE005002007",
+			"User not found.",
 			err.Error(),
 		)
 	}
 
-	if isAlreadySubmit {
+	cardRecordList, submissionSlot, err := u.prepareCardRecords(userObjectId, req.GroupCode, req.Answers, u.clock.Now(), existUser.Location())
+	if err == nil {
+		err = u.saveRecords(&[]record.SubmissionSlot{*submissionSlot}, func() error {
+			return u.cardRecordRepo.CreateManyGroupRecord(&cardRecordList)
+		})
+	}
+
+	switch {
+	case err == nil:
+		return nil
+	case goerrors.Is(err, errInvalidCard):
+		var answerErrors cardAnswerErrors
+		goerrors.As(err, &answerErrors)
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005002002",
+			"Failed to check card",
+			err.Error(),
+		).WithDetails(answerErrors)
+	case goerrors.Is(err, errSelectionLimit):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005002008",
+			"Too many cards picked.",
+			err.Error(),
+		)
+	case goerrors.Is(err, record.ErrAlreadySubmitted):
 		return errors.NewCustomError(
 			http.StatusBadRequest,
 			"UCE005002005",
-			"You can only submit once per day",
-			"",
+			"You have already submitted for the current period.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errWindowClosed):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005002006",
+			"Cards are not available right now.",
+			err.Error(),
+		)
+	default:
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005002003",
+			"Failed to insert records.",
+			err.Error(),
 		)
 	}
+}
 
-	for _, answer := range req.Answers {
-		cardObjectId, err := primitive.ObjectIDFromHex(answer.Card)
+func (u *RecordUseCaseImpl) CreateStoryRecord(req *CreateStoryRequest, claims *security.AccessTokenModel) (*CreateStoryOutput, error) {
+	userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005003001",
+			"Failed to check user.",
+			err.Error(),
+		)
+	}
+
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005003003",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	now := u.clock.Now()
+	newStoryRecord := record.NewStoryRecord(req.GroupCode, userObjectId, req.Content, now, now, existUser.Location().String())
+	u.screenStory(newStoryRecord)
+	err = u.storyRecordRepo.CreateStoryRecord(newStoryRecord)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005003002",
+			"Failed to insert story.",
+			err.Error(),
+		)
+	}
+
+	return &CreateStoryOutput{
+		Support: u.escalateStory(newStoryRecord),
+	}, nil
+}
+
+// SyncSubmissions stores submissions queued by the app while offline. Each item
+// keeps its client timestamp and the schedule rules use the client's local
+// time, which may only drift a few hours from the user's stored timezone. One
+// rejected item does not prevent the others from being saved.
+func (u *RecordUseCaseImpl) SyncSubmissions(req *SyncSubmissionRequest, claims *security.AccessTokenModel) (*SyncSubmissionOutput, error) {
+	userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005004001",
+			"Failed to check user.",
+			err.Error(),
+		)
+	}
+
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005004005",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	maxPast, maxFuture, err := u.syncSkewWindow()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusInternalServerError,
+			"UCE005004002",
+			"Internal server error.",
+			err.Error(),
+		)
+	}
+
+	userLocation := existUser.Location()
+	now := u.clock.Now()
+	results := make([]SyncSubmissionResult, 0, len(req.Submissions))
+	for index, item := range req.Submissions {
+		result := SyncSubmissionResult{
+			Index: index,
+			Type:  item.Type,
+		}
+
+		location, err := time.LoadLocation(item.Timezone)
 		if err != nil {
-			return errors.NewCustomError(
-				http.StatusBadRequest,
-				"UCE005002002",
-				"Failed to check card",
-				err.Error(),
-			)
+			result.Code = "UCE005004003"
+			result.Message = "Invalid timezone."
+			results = append(results, result)
+			continue
 		}
 
-		cardRecord := record.NewCardRecord(req.GroupCode, userObjectId, cardObjectId, timestamp)
-		cardRecordList = append(cardRecordList, *cardRecord)
+		if zoneDrift(item.SubmittedAt, location, userLocation) > syncMaxZoneDriftHour*time.Hour {
+			result.Code = "UCE005004006"
+			result.Message = "Timezone is too far from the user's timezone."
+			results = append(results, result)
+			continue
+		}
+
+		if item.SubmittedAt.Before(now.Add(-maxPast)) || item.SubmittedAt.After(now.Add(maxFuture)) {
+			result.Code = "UCE005004004"
+			result.Message = "Submission time is outside the accepted window."
+			results = append(results, result)
+			continue
+		}
+
+		result.LocalDate = item.SubmittedAt.In(location).Format(time.DateOnly)
+
+		var syncErr *errors.CustomError
+		var support *risk.SupportContent
+		var questionnaireResult *questionnaire.Result
+		switch item.Type {
+		case record.SubmissionTypeGroup:
+			syncErr = u.syncGroupSubmission(userObjectId, &item, location)
+		case record.SubmissionTypeQuestionnaire:
+			questionnaireResult, syncErr = u.syncQuestionnaireSubmission(userObjectId, &item, location)
+		case record.SubmissionTypeCard:
+			syncErr = u.syncCardSubmission(userObjectId, &item, location)
+		case record.SubmissionTypeStory:
+			support, syncErr = u.syncStorySubmission(userObjectId, &item, location)
+		}
+
+		if syncErr != nil {
+			result.Code = syncErr.Code
+			result.Message = syncErr.Message
+			result.Details = syncErr.Details
+			results = append(results, result)
+			continue
+		}
+
+		result.Accepted = true
+		result.Support = support
+		result.Result = questionnaireResult
+		results = append(results, result)
 	}
 
-	err = u.cardRecordRepo.CreateManyGroupRecord((&cardRecordList))
-	if err != nil {
+	return &SyncSubmissionOutput{
+		Results: results,
+	}, nil
+}
+
+func (u *RecordUseCaseImpl) syncGroupSubmission(userObjectId primitive.ObjectID, item *SyncSubmissionItem, location *time.Location) *errors.CustomError {
+	groupRecordList, submissionSlotList, err := u.prepareGroupRecords(userObjectId, item.GroupCode, item.GroupAnswers, item.SubmittedAt, location)
+	if err == nil {
+		err = u.saveRecords(&submissionSlotList, func() error {
+			return u.groupRecordRepo.CreateManyGroupRecord(&groupRecordList)
+		})
+	}
+
+	switch {
+	case err == nil:
+		return nil
+	case goerrors.Is(err, errInvalidQuestionGroup):
 		return errors.NewCustomError(
 			http.StatusBadRequest,
-			"UCE005002003",
+			"UCE005005001",
+			"Failed to check question group.",
+			err.Error(),
+		)
+	case goerrors.Is(err, question.ErrInvalidAnswer):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005005005",
+			"Answer does not match the question scale.",
+			err.Error(),
+		)
+	case goerrors.Is(err, question.ErrConditionNotMet):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005005006",
+			"Question group was skipped by its conditions.",
+			err.Error(),
+		)
+	case goerrors.Is(err, record.ErrAlreadySubmitted):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005005002",
+			"You have already submitted for that period.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errWindowClosed):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005005004",
+			"This questionnaire was not available at that time.",
+			err.Error(),
+		)
+	default:
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005005003",
 			"Failed to insert records.",
 			err.Error(),
 		)
 	}
+}
 
-	return nil
+func (u *RecordUseCaseImpl) syncQuestionnaireSubmission(userObjectId primitive.ObjectID, item *SyncSubmissionItem, location *time.Location) (*questionnaire.Result, *errors.CustomError) {
+	groupRecordList, submissionSlot, result, err := u.prepareQuestionnaireRecords(userObjectId, item.Questionnaire, item.GroupCode, item.GroupAnswers, item.SubmittedAt, location)
+	if err == nil {
+		err = u.saveRecords(&[]record.SubmissionSlot{*submissionSlot}, func() error {
+			return u.groupRecordRepo.CreateManyGroupRecord(&groupRecordList)
+		})
+	}
 
+	switch {
+	case err == nil:
+		return result, nil
+	case goerrors.Is(err, errInvalidQuestionnaire):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005010001",
+			"Questionnaire not found.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errInvalidQuestionGroup):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005010002",
+			"Failed to check question group.",
+			err.Error(),
+		)
+	case goerrors.Is(err, question.ErrInvalidAnswer):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005010006",
+			"Answer does not match the question scale.",
+			err.Error(),
+		)
+	case goerrors.Is(err, question.ErrConditionNotMet):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005010007",
+			"Question group was skipped by its conditions.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errIncomplete):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005010008",
+			"Every question group of the questionnaire must be answered.",
+			err.Error(),
+		)
+	case goerrors.Is(err, record.ErrAlreadySubmitted):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005010003",
+			"You have already submitted for that period.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errWindowClosed):
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005010004",
+			"This questionnaire was not available at that time.",
+			err.Error(),
+		)
+	default:
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005010005",
+			"Failed to insert records.",
+			err.Error(),
+		)
+	}
 }
 
-func (u *RecordUseCaseImpl) CreateStoryRecord(req *CreateStoryRequest, claims *security.AccessTokenModel) error {
-	userObjectId, err := primitive.ObjectIDFromHex(claims.ID)
-	if err != nil {
+func (u *RecordUseCaseImpl) syncCardSubmission(userObjectId primitive.ObjectID, item *SyncSubmissionItem, location *time.Location) *errors.CustomError {
+	cardRecordList, submissionSlot, err := u.prepareCardRecords(userObjectId, item.GroupCode, item.CardAnswers, item.SubmittedAt, location)
+	if err == nil {
+		err = u.saveRecords(&[]record.SubmissionSlot{*submissionSlot}, func() error {
+			return u.cardRecordRepo.CreateManyGroupRecord(&cardRecordList)
+		})
+	}
+
+	switch {
+	case err == nil:
+		return nil
+	case goerrors.Is(err, errInvalidCard):
+		var answerErrors cardAnswerErrors
+		goerrors.As(err, &answerErrors)
 		return errors.NewCustomError(
 			http.StatusBadRequest,
-			"UCE005003001",
-			"Failed to check user.",
+			"UCE005006001",
+			"Failed to check card",
+			err.Error(),
+		).WithDetails(answerErrors)
+	case goerrors.Is(err, errSelectionLimit):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005006005",
+			"Too many cards picked.",
+			err.Error(),
+		)
+	case goerrors.Is(err, record.ErrAlreadySubmitted):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005006002",
+			"You have already submitted for that period.",
+			err.Error(),
+		)
+	case goerrors.Is(err, errWindowClosed):
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005006004",
+			"Cards were not available at that time.",
+			err.Error(),
+		)
+	default:
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005006003",
+			"Failed to insert records.",
 			err.Error(),
 		)
 	}
+}
 
-	newStoryRecord := record.NewStoryRecord(req.GroupCode, userObjectId, req.Content)
-	err = u.storyRecordRepo.CreateStoryRecord(newStoryRecord)
+func (u *RecordUseCaseImpl) syncStorySubmission(userObjectId primitive.ObjectID, item *SyncSubmissionItem, location *time.Location) (*risk.SupportContent, *errors.CustomError) {
+	newStoryRecord := record.NewStoryRecord(item.GroupCode, userObjectId, item.Content, item.SubmittedAt, u.clock.Now(), item.Timezone)
+	u.screenStory(newStoryRecord)
+	err := u.storyRecordRepo.CreateStoryRecord(newStoryRecord)
 	if err != nil {
-		return errors.NewCustomError(
+		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
-			"UCE005003002",
+			"UCE005007001",
 			"Failed to insert story.",
 			err.Error(),
 		)
 	}
 
+	return u.escalateStory(newStoryRecord), nil
+}
+
+// screenStory scores the story against the risk keyword lists. A failed
+// assessment never blocks the participant from saving their entry.
+func (u *RecordUseCaseImpl) screenStory(storyRecord *record.StoryRecord) {
+	assessment, err := u.riskDetectionService.Assess(storyRecord.Content)
+	if err != nil {
+		log.Printf("Failed to assess story %s: %v", storyRecord.ID.Hex(), err)
+		return
+	}
+
+	storyRecord.RiskScore = assessment.Score
+	storyRecord.RiskKeywords = assessment.Keywords
+	storyRecord.Flagged = assessment.Flagged
+	storyRecord.RiskSeverity = assessment.Severity
+}
+
+// escalateStory notifies the counsellor admins about a flagged story and
+// returns the support content that should be shown to the participant.
+func (u *RecordUseCaseImpl) escalateStory(storyRecord *record.StoryRecord) *risk.SupportContent {
+	if !storyRecord.Flagged {
+		return nil
+	}
+
+	// ✅ A slow webhook must not hold up the participant's request
+	go u.sendRiskAlert(&notification.RiskAlert{
+		StoryRecord: storyRecord.ID.Hex(),
+		User:        storyRecord.User.Hex(),
+		Severity:    storyRecord.RiskSeverity,
+		CreatedAt:   storyRecord.CreatedAt,
+	})
+
+	support := risk.DefaultSupportContent
+	return &support
+}
+
+// sendRiskAlert addresses the alert to the counsellor admins and sends it.
+// Failures are only logged because the story is already saved.
+func (u *RecordUseCaseImpl) sendRiskAlert(alert *notification.RiskAlert) {
+	alert.Recipients = make([]string, 0)
+	admins, err := u.adminRepo.FindRiskAlertAdmins()
+	if err != nil {
+		log.Printf("Failed to find risk alert recipients: %v", err)
+	} else {
+		for _, recipient := range *admins {
+			alert.Recipients = append(alert.Recipients, recipient.Email)
+		}
+	}
+
+	err = u.riskNotifier.NotifyRiskAlert(alert)
+	if err != nil {
+		log.Printf("Failed to send risk alert for story %s: %v", alert.StoryRecord, err)
+	}
+}
+
+// GetHistory summarises the caller's submissions per day in their own
+// timezone, together with the current streak of consecutive active days.
+func (u *RecordUseCaseImpl) GetHistory(req *GetHistoryRequest, claims *security.AccessTokenModel) (*GetHistoryOutput, error) {
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005008001",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	location := existUser.Location()
+	now := u.clock.Now().In(location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	start := today.AddDate(0, 0, -streakLookbackDays)
+	end := today.AddDate(0, 0, 1)
+
+	groupCounts, err := u.groupRecordRepo.CountDailyRecords(existUser.ID, start, end, location.String())
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005008002",
+			"Failed to get history.",
+			err.Error(),
+		)
+	}
+
+	cardCounts, err := u.cardRecordRepo.CountDailyRecords(existUser.ID, start, end, location.String())
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005008003",
+			"Failed to get history.",
+			err.Error(),
+		)
+	}
+
+	storyCounts, err := u.storyRecordRepo.CountDailyRecords(existUser.ID, start, end, location.String())
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005008004",
+			"Failed to get history.",
+			err.Error(),
+		)
+	}
+
+	isActive := func(date string) bool {
+		return groupCounts[date]+cardCounts[date]+storyCounts[date] > 0
+	}
+
+	// ✅ Today without a submission yet does not break the streak
+	streak := 0
+	day := today
+	if !isActive(day.Format(time.DateOnly)) {
+		day = day.AddDate(0, 0, -1)
+	}
+	for !day.Before(start) && isActive(day.Format(time.DateOnly)) {
+		streak++
+		day = day.AddDate(0, 0, -1)
+	}
+
+	days := make([]DailyHistory, 0, req.Days)
+	for i := 0; i < req.Days; i++ {
+		date := today.AddDate(0, 0, -i).Format(time.DateOnly)
+		days = append(days, DailyHistory{
+			Date:         date,
+			GroupRecords: groupCounts[date],
+			CardRecords:  cardCounts[date],
+			StoryRecords: storyCounts[date],
+		})
+	}
+
+	return &GetHistoryOutput{
+		Timezone: location.String(),
+		Streak:   streak,
+		Days:     days,
+	}, nil
+}
+
+// GetCardSummary returns the cards the caller picked in one calendar month of
+// their own timezone, the current month unless another is requested. Only the
+// caller's own records are read.
+func (u *RecordUseCaseImpl) GetCardSummary(req *GetCardSummaryRequest, claims *security.AccessTokenModel) (*GetCardSummaryOutput, error) {
+	existUser, err := u.userRepo.FindUserById(claims.ID)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005011001",
+			"User not found.",
+			err.Error(),
+		)
+	}
+
+	location := existUser.Location()
+	now := u.clock.Now().In(location)
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, location)
+	if req.Month != nil {
+		start, err = time.ParseInLocation(summaryMonthLayout, *req.Month, location)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE005011002",
+				"Invalid month.",
+				err.Error(),
+			)
+		}
+	}
+
+	filter := record.CardStatisticsFilter{
+		Start:    start,
+		End:      start.AddDate(0, 1, 0),
+		Interval: record.IntervalDay,
+		Timezone: location.String(),
+	}
+
+	picks, err := u.cardRecordRepo.CountUserCardPicks(existUser.ID, filter)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE005011003",
+			"Failed to get card summary.",
+			err.Error(),
+		)
+	}
+
+	names := make(map[primitive.ObjectID]string)
+	missing := make([]primitive.ObjectID, 0)
+	for _, pick := range picks {
+		if _, ok := names[pick.Card]; ok {
+			continue
+		}
+		names[pick.Card] = pick.CardName
+		if pick.CardName == "" {
+			missing = append(missing, pick.Card)
+		}
+	}
+
+	// ✅ Records saved before card names were kept fall back to the current card
+	if len(missing) > 0 {
+		existCards, err := u.cardRepo.FindCardsByIds(missing)
+		if err != nil {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE005011004",
+				"Failed to get card summary.",
+				err.Error(),
+			)
+		}
+		name := locale.Resolve(req.AcceptLanguage, existUser.Language)
+		for _, existCard := range *existCards {
+			existCard.Localize(name)
+			names[existCard.ID] = existCard.Name
+		}
+	}
+
+	total := 0
+	cardIndex := make(map[primitive.ObjectID]int)
+	cards := make([]CardSummaryItem, 0)
+	dayIndex := make(map[string]int)
+	days := make([]DailyCardSummary, 0)
+	for _, pick := range picks {
+		total += pick.Count
+
+		if i, ok := cardIndex[pick.Card]; ok {
+			cards[i].Count += pick.Count
+		} else {
+			cardIndex[pick.Card] = len(cards)
+			cards = append(cards, CardSummaryItem{
+				Card:  pick.Card,
+				Name:  names[pick.Card],
+				Count: pick.Count,
+			})
+		}
+
+		i, ok := dayIndex[pick.Period]
+		if !ok {
+			i = len(days)
+			dayIndex[pick.Period] = i
+			days = append(days, DailyCardSummary{
+				Date:  pick.Period,
+				Cards: make([]CardSummaryItem, 0),
+			})
+		}
+		days[i].Cards = append(days[i].Cards, CardSummaryItem{
+			Card:  pick.Card,
+			Name:  names[pick.Card],
+			Count: pick.Count,
+		})
+	}
+
+	sort.SliceStable(cards, func(i, j int) bool {
+		return cards[i].Count > cards[j].Count
+	})
+
+	return &GetCardSummaryOutput{
+		Month:    start.Format(summaryMonthLayout),
+		Timezone: location.String(),
+		Total:    total,
+		Cards:    cards,
+		Days:     days,
+	}, nil
+}
+
+// prepareGroupRecords checks every answered group against its schedule and the
+// user's profile conditions at the given time and builds the records together
+// with one slot per group.
+func (u *RecordUseCaseImpl) prepareGroupRecords(
+	userObjectId primitive.ObjectID,
+	groupCode *string,
+	answers []GroupRecordAnswer,
+	at time.Time,
+	location *time.Location,
+) ([]record.GroupRecord, []record.SubmissionSlot, error) {
+	existUser, err := u.userRepo.FindUserById(userObjectId.Hex())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ✅ Groups outside a questionnaire have no answer order, so only the
+	// profile conditions can hold, as in the exam that served them
+	state := question.NewBranchState(question.NewProfile(existUser.GroupCode, existUser.Language))
+
+	var groupRecordList []record.GroupRecord
+	var submissionSlotList []record.SubmissionSlot
+
+	for _, answer := range answers {
+		questionGroup, err := u.questionGroupRepo.FindQuestionGroupById(answer.QuestionGroup)
+		if err != nil {
+			return nil, nil, goerrors.Join(errInvalidQuestionGroup, err)
+		}
+
+		if questionGroup.ArchivedAt != nil {
+			return nil, nil, goerrors.Join(errInvalidQuestionGroup, errArchived)
+		}
+
+		liveRevision, err := u.questionRevisionRepo.FindLiveQuestionRevision(questionGroup.ID, at)
+		if err != nil {
+			return nil, nil, goerrors.Join(errInvalidQuestionGroup, errNotPublished, err)
+		}
+
+		revision, err := u.answeredRevision(liveRevision, answer.Revision, at)
+		if err != nil {
+			return nil, nil, goerrors.Join(errInvalidQuestionGroup, err)
+		}
+
+		if !revision.Eligible(state) {
+			return nil, nil, question.ErrConditionNotMet
+		}
+
+		score, size, values, err := scoreAnswer(revision, &answer)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		liveQuestionGroup := liveRevision.Snapshot()
+		availability, err := u.availabilityService.GroupAvailability(userObjectId, &liveQuestionGroup, at, location)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !availability.Available {
+			if availability.CurrentWindow != nil {
+				return nil, nil, record.ErrAlreadySubmitted
+			}
+			return nil, nil, errWindowClosed
+		}
+
+		groupRecord := record.NewGroupRecord(groupCode, userObjectId, questionGroup.ID, &revision.ID, score, size, at, u.clock.Now(), location.String())
+		groupRecord.Answers = values
+		groupRecordList = append(groupRecordList, *groupRecord)
+
+		submissionSlot := record.NewSubmissionSlot(userObjectId, record.SubmissionTypeGroup, &questionGroup.ID, availability.CurrentWindow.SlotKey)
+		submissionSlotList = append(submissionSlotList, *submissionSlot)
+	}
+
+	return groupRecordList, submissionSlotList, nil
+}
+
+// prepareQuestionnaireRecords checks the questionnaire schedule at the given
+// time, builds one record per answered group tagged with the questionnaire and
+// scores the submission. The whole submission occupies a single slot.
+func (u *RecordUseCaseImpl) prepareQuestionnaireRecords(
+	userObjectId primitive.ObjectID,
+	questionnaireId string,
+	groupCode *string,
+	answers []GroupRecordAnswer,
+	at time.Time,
+	location *time.Location,
+) ([]record.GroupRecord, *record.SubmissionSlot, *questionnaire.Result, error) {
+	existQuestionnaire, err := u.questionnaireRepo.FindQuestionnaireById(questionnaireId)
+	if err != nil {
+		return nil, nil, nil, goerrors.Join(errInvalidQuestionnaire, err)
+	}
+
+	if !existQuestionnaire.Active {
+		return nil, nil, nil, errInvalidQuestionnaire
+	}
+
+	availability, err := u.availabilityService.QuestionnaireAvailability(userObjectId, existQuestionnaire, at, location)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !availability.Available {
+		if availability.CurrentWindow != nil {
+			return nil, nil, nil, record.ErrAlreadySubmitted
+		}
+		return nil, nil, nil, errWindowClosed
+	}
+
+	existUser, err := u.userRepo.FindUserById(userObjectId.Hex())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var groupRecordList []record.GroupRecord
+	var groupScores []int
+	var revisions []*question.QuestionRevision
+	answered := make(map[primitive.ObjectID]bool)
+	state := question.NewBranchState(question.NewProfile(existUser.GroupCode, existUser.Language))
+	for _, answer := range answers {
+		questionGroup, err := u.questionGroupRepo.FindQuestionGroupById(answer.QuestionGroup)
+		if err != nil {
+			return nil, nil, nil, goerrors.Join(errInvalidQuestionGroup, err)
+		}
+
+		if !existQuestionnaire.Contains(questionGroup.ID) || answered[questionGroup.ID] {
+			return nil, nil, nil, errInvalidQuestionGroup
+		}
+		answered[questionGroup.ID] = true
+
+		if questionGroup.ArchivedAt != nil {
+			return nil, nil, nil, goerrors.Join(errInvalidQuestionGroup, errArchived)
+		}
+
+		liveRevision, err := u.questionRevisionRepo.FindLiveQuestionRevision(questionGroup.ID, at)
+		if err != nil {
+			return nil, nil, nil, goerrors.Join(errInvalidQuestionGroup, errNotPublished, err)
+		}
+
+		revision, err := u.answeredRevision(liveRevision, answer.Revision, at)
+		if err != nil {
+			return nil, nil, nil, goerrors.Join(errInvalidQuestionGroup, err)
+		}
+
+		score, size, values, err := scoreAnswer(revision, &answer)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		groupRecord := record.NewGroupRecord(groupCode, userObjectId, questionGroup.ID, &revision.ID, score, size, at, u.clock.Now(), location.String())
+		groupRecord.Questionnaire = &existQuestionnaire.ID
+		groupRecord.Answers = values
+		groupRecordList = append(groupRecordList, *groupRecord)
+		groupScores = append(groupScores, score)
+		revisions = append(revisions, revision)
+		state.Answer(questionGroup.ID, score, values)
+	}
+
+	// ✅ Every answered group must be reachable from the other answers
+	for _, revision := range revisions {
+		if !revision.Eligible(state) {
+			return nil, nil, nil, question.ErrConditionNotMet
+		}
+	}
+
+	liveRevisions, err := u.questionRevisionRepo.FindLiveQuestionRevisions(at)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	revisionsByGroup := make(map[primitive.ObjectID]*question.QuestionRevision, len(*liveRevisions))
+	for i := range *liveRevisions {
+		revisionsByGroup[(*liveRevisions)[i].QuestionGroup] = &(*liveRevisions)[i]
+	}
+
+	// ✅ A score and band are only given for a sitting that answered every
+	// group its conditions lead to
+	if next, _ := existQuestionnaire.NextGroup(revisionsByGroup, answered, state); next != nil {
+		return nil, nil, nil, fmt.Errorf("%w: question group %s is not answered", errIncomplete, next.QuestionGroup.Hex())
+	}
+
+	submissionSlot := record.NewSubmissionSlot(userObjectId, record.SubmissionTypeQuestionnaire, &existQuestionnaire.ID, availability.CurrentWindow.SlotKey)
+
+	return groupRecordList, submissionSlot, existQuestionnaire.Score(groupScores), nil
+}
+
+// answeredRevision resolves the revision a participant answered. Clients send
+// the revision they were shown, which may predate the live one; older clients
+// that omit it are credited with the live revision.
+func (u *RecordUseCaseImpl) answeredRevision(liveRevision *question.QuestionRevision, revisionId string, at time.Time) (*question.QuestionRevision, error) {
+	if revisionId == "" || revisionId == liveRevision.ID.Hex() {
+		return liveRevision, nil
+	}
+
+	questionRevision, err := u.questionRevisionRepo.FindQuestionRevisionById(revisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if questionRevision.QuestionGroup != liveRevision.QuestionGroup {
+		return nil, errRevisionMismatch
+	}
+
+	if questionRevision.PublishedAt.After(at) {
+		return nil, errNotPublished
+	}
+
+	return questionRevision, nil
+}
+
+// scoreAnswer scores the values picked for each choice on the scale of the
+// answered revision, reversing inverted choices. Only groups without a scale
+// are recorded from the total the client sends.
+func scoreAnswer(revision *question.QuestionRevision, answer *GroupRecordAnswer) (int, int, []question.ChoiceAnswer, error) {
+	values := make([]question.ChoiceAnswer, 0, len(answer.Values))
+	for _, value := range answer.Values {
+		choiceObjectId, err := primitive.ObjectIDFromHex(value.Choice)
+		if err != nil {
+			return 0, 0, nil, goerrors.Join(question.ErrInvalidAnswer, err)
+		}
+		values = append(values, question.ChoiceAnswer{
+			Choice: choiceObjectId,
+			Value:  *value.Value,
+		})
+	}
+
+	score, size, err := revision.Answer(values, answer.Score, answer.QuestionSize)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	if len(values) == 0 {
+		values = nil
+	}
+
+	return score, size, values, nil
+}
+
+// cardAnswerErrors lists every submitted card that can not be recorded.
+type cardAnswerErrors []CardAnswerError
+
+func (e cardAnswerErrors) Error() string {
+	return fmt.Sprintf("%d of the submitted cards are invalid", len(e))
+}
+
+// prepareCardRecords checks the card activity schedule at the given time,
+// that every card exists, is active and is picked only once, and the selection
+// limit of every card category, and builds the records together with the slot
+// they occupy.
+func (u *RecordUseCaseImpl) prepareCardRecords(
+	userObjectId primitive.ObjectID,
+	groupCode *string,
+	answers []CardRecordAnswer,
+	at time.Time,
+	location *time.Location,
+) ([]record.CardRecord, *record.SubmissionSlot, error) {
+	availability, err := u.availabilityService.CardAvailability(userObjectId, at, location)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !availability.Available {
+		if availability.CurrentWindow != nil {
+			return nil, nil, record.ErrAlreadySubmitted
+		}
+		return nil, nil, errWindowClosed
+	}
+
+	cardObjectIds := make([]primitive.ObjectID, 0, len(answers))
+	for _, answer := range answers {
+		cardObjectId, err := primitive.ObjectIDFromHex(answer.Card)
+		if err == nil {
+			cardObjectIds = append(cardObjectIds, cardObjectId)
+		}
+	}
+
+	existCards, err := u.cardRepo.FindCardsByIds(cardObjectIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cardCategories, err := u.cardCategoryRepo.FindAllCardCategories()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cardsById := make(map[primitive.ObjectID]card.Card, len(*existCards))
+	for _, existCard := range *existCards {
+		cardsById[existCard.ID] = existCard
+	}
+
+	// ✅ Check every answer so the client can fix the whole submission at once
+	var answerErrors cardAnswerErrors
+	seen := make(map[primitive.ObjectID]bool, len(answers))
+	pickedCards := make([]card.Card, 0, len(answers))
+	cardRecordList := make([]record.CardRecord, 0, len(answers))
+	for index, answer := range answers {
+		answerError := CardAnswerError{
+			Index: index,
+			Card:  answer.Card,
+		}
+
+		cardObjectId, err := primitive.ObjectIDFromHex(answer.Card)
+		if err != nil {
+			answerError.Reason = CardAnswerInvalidId
+			answerErrors = append(answerErrors, answerError)
+			continue
+		}
+
+		existCard, ok := cardsById[cardObjectId]
+		switch {
+		case !ok:
+			answerError.Reason = CardAnswerNotFound
+		case existCard.ArchivedAt != nil:
+			answerError.Reason = CardAnswerArchived
+		case !existCard.IsActive:
+			answerError.Reason = CardAnswerInactive
+		case seen[cardObjectId]:
+			answerError.Reason = CardAnswerDuplicate
+		}
+		if answerError.Reason != "" {
+			answerErrors = append(answerErrors, answerError)
+			continue
+		}
+		seen[cardObjectId] = true
+		pickedCards = append(pickedCards, existCard)
+
+		// ✅ Keep the card name so the history still reads the same after the card is renamed
+		cardRecord := record.NewCardRecord(groupCode, userObjectId, cardObjectId, existCard.Name, at, u.clock.Now(), location.String())
+		cardRecordList = append(cardRecordList, *cardRecord)
+	}
+
+	if len(answerErrors) > 0 {
+		return nil, nil, goerrors.Join(errInvalidCard, answerErrors)
+	}
+
+	err = card.CheckSelection(pickedCards, *cardCategories)
+	if err != nil {
+		return nil, nil, goerrors.Join(errSelectionLimit, err)
+	}
+
+	submissionSlot := record.NewSubmissionSlot(userObjectId, record.SubmissionTypeCard, nil, availability.CurrentWindow.SlotKey)
+
+	return cardRecordList, submissionSlot, nil
+}
+
+// saveRecords claims the submission slots before inserting the records and
+// releases them again when the insert fails, so the user can retry.
+func (u *RecordUseCaseImpl) saveRecords(submissionSlots *[]record.SubmissionSlot, insert func() error) error {
+	err := u.submissionSlotRepo.CreateManySubmissionSlot(submissionSlots)
+	if err != nil {
+		return err
+	}
+
+	err = insert()
+	if err != nil {
+		var ids []string
+		for _, submissionSlot := range *submissionSlots {
+			ids = append(ids, submissionSlot.ID.Hex())
+		}
+		_ = u.submissionSlotRepo.RemoveManySubmissionSlotById(ids)
+		return err
+	}
+
 	return nil
 }
+
+// zoneDrift returns how far apart the UTC offsets of two locations are at t.
+func zoneDrift(t time.Time, location, other *time.Location) time.Duration {
+	_, offset := t.In(location).Zone()
+	_, otherOffset := t.In(other).Zone()
+
+	drift := time.Duration(offset-otherOffset) * time.Second
+	if drift < 0 {
+		return -drift
+	}
+
+	return drift
+}
+
+func (u *RecordUseCaseImpl) syncSkewWindow() (time.Duration, time.Duration, error) {
+	maxPastHour := defaultSyncMaxPastHour
+	if u.cfg.SyncMaxPastHour != "" {
+		value, err := strconv.Atoi(u.cfg.SyncMaxPastHour)
+		if err != nil {
+			return 0, 0, err
+		}
+		maxPastHour = value
+	}
+
+	maxFutureMinute := defaultSyncMaxFutureMinute
+	if u.cfg.SyncMaxFutureMinute != "" {
+		value, err := strconv.Atoi(u.cfg.SyncMaxFutureMinute)
+		if err != nil {
+			return 0, 0, err
+		}
+		maxFutureMinute = value
+	}
+
+	return time.Duration(maxPastHour) * time.Hour, time.Duration(maxFutureMinute) * time.Minute, nil
+}
@@ -0,0 +1,44 @@
+package risk
+
+import (
+	"mucb_be/internal/domain/risk"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CreateRiskKeywordRequest struct {
+	Phrase   string `json:"phrase" binding:"required,max=128"`
+	Language string `json:"language" binding:"required,oneof=th en"`
+	Weight   int    `json:"weight" binding:"required,min=1,max=100"`
+}
+
+type GetAllRiskKeywordsOutput struct {
+	Items *[]risk.RiskKeyword `json:"items"`
+}
+
+type UpdateRiskKeywordRequest struct {
+	RiskKeyword string `json:"riskKeyword" binding:"required"`
+	Phrase      string `json:"phrase" binding:"required,max=128"`
+	Language    string `json:"language" binding:"required,oneof=th en"`
+	Weight      int    `json:"weight" binding:"required,min=1,max=100"`
+}
+
+type RemoveRiskKeywordRequest struct {
+	RiskKeyword string `json:"riskKeyword" binding:"required"`
+}
+
+// FlaggedStory describes a flagged entry without its content. Counsellors
+// read the entry itself through the participants they are assigned to, which
+// checks consent and writes the access log.
+type FlaggedStory struct {
+	ID        primitive.ObjectID `json:"id"`
+	User      primitive.ObjectID `json:"user"`
+	GroupCode *string            `json:"groupCode"`
+	RiskScore int                `json:"riskScore"`
+	CreatedAt time.Time          `json:"createdAt"`
+}
+
+type GetFlaggedStoriesOutput struct {
+	Items []FlaggedStory `json:"items"`
+}
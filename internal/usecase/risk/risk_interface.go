@@ -0,0 +1,9 @@
+package risk
+
+type RiskInterface interface {
+	CreateRiskKeyword(req *CreateRiskKeywordRequest) (string, error)
+	FindAllRiskKeywords() (*GetAllRiskKeywordsOutput, error)
+	UpdateRiskKeyword(req *UpdateRiskKeywordRequest) error
+	RemoveRiskKeyword(req *RemoveRiskKeywordRequest) error
+	FindFlaggedStories() (*GetFlaggedStoriesOutput, error)
+}
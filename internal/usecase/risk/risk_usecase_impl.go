@@ -0,0 +1,135 @@
+package risk
+
+import (
+	goerrors "errors"
+	"mucb_be/internal/domain/record"
+	"mucb_be/internal/domain/risk"
+	"mucb_be/internal/errors"
+	"net/http"
+	"strings"
+)
+
+const flaggedStoryLimit = 200
+
+type RiskUseCaseImpl struct {
+	riskKeywordRepo risk.RiskKeywordRepository
+	storyRecordRepo record.StoryRecordRepository
+}
+
+func NewRiskUseCase(
+	riskKeywordRepo risk.RiskKeywordRepository,
+	storyRecordRepo record.StoryRecordRepository,
+) RiskInterface {
+	return &RiskUseCaseImpl{
+		riskKeywordRepo: riskKeywordRepo,
+		storyRecordRepo: storyRecordRepo,
+	}
+}
+
+func (u *RiskUseCaseImpl) CreateRiskKeyword(req *CreateRiskKeywordRequest) (string, error) {
+	newRiskKeyword := risk.NewRiskKeyword(strings.TrimSpace(req.Phrase), req.Language, req.Weight)
+	err := u.riskKeywordRepo.CreateRiskKeyword(newRiskKeyword)
+	if err != nil {
+		if goerrors.Is(err, risk.ErrDuplicatePhrase) {
+			return "", errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE009001001",
+				"Phrase duplicated.",
+				err.Error(),
+			)
+		}
+
+		return "", errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE009001002",
+			"Failed to insert risk keyword.",
+			err.Error(),
+		)
+	}
+
+	return newRiskKeyword.ID.Hex(), nil
+}
+
+func (u *RiskUseCaseImpl) FindAllRiskKeywords() (*GetAllRiskKeywordsOutput, error) {
+	riskKeywords, err := u.riskKeywordRepo.FindAllRiskKeywords()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE009002001",
+			"Failed to get risk keywords.",
+			err.Error(),
+		)
+	}
+
+	return &GetAllRiskKeywordsOutput{
+		Items: riskKeywords,
+	}, nil
+}
+
+func (u *RiskUseCaseImpl) UpdateRiskKeyword(req *UpdateRiskKeywordRequest) error {
+	err := u.riskKeywordRepo.UpdateRiskKeywordById(req.RiskKeyword, strings.TrimSpace(req.Phrase), req.Language, req.Weight)
+	if err != nil {
+		if goerrors.Is(err, risk.ErrDuplicatePhrase) {
+			return errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE009003001",
+				"Phrase duplicated.",
+				err.Error(),
+			)
+		}
+
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE009003002",
+			"Failed to update risk keyword.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+func (u *RiskUseCaseImpl) RemoveRiskKeyword(req *RemoveRiskKeywordRequest) error {
+	err := u.riskKeywordRepo.RemoveRiskKeywordById(req.RiskKeyword)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE009004001",
+			"Failed to remove risk keyword.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
+
+// FindFlaggedStories lists the latest flagged stories for triage. Their
+// content is left out because the caller is not checked against any
+// counsellor assignment or participant consent.
+func (u *RiskUseCaseImpl) FindFlaggedStories() (*GetFlaggedStoriesOutput, error) {
+	storyRecords, err := u.storyRecordRepo.FindFlaggedStoryRecords(flaggedStoryLimit)
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE009005001",
+			"Failed to get flagged stories.",
+			err.Error(),
+		)
+	}
+
+	// ✅ Only metadata leaves here, the content needs an assignment and consent
+	items := make([]FlaggedStory, 0, len(*storyRecords))
+	for _, storyRecord := range *storyRecords {
+		items = append(items, FlaggedStory{
+			ID:        storyRecord.ID,
+			User:      storyRecord.User,
+			GroupCode: storyRecord.GroupCode,
+			RiskScore: storyRecord.RiskScore,
+			CreatedAt: storyRecord.CreatedAt,
+		})
+	}
+
+	return &GetFlaggedStoriesOutput{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package translation
+
+const (
+	EntityQuestionGroup  = "QUESTION_GROUP"
+	EntityQuestionChoice = "QUESTION_CHOICE"
+	EntityCard           = "CARD"
+	EntityCardCategory   = "CARD_CATEGORY"
+	EntityHealthScore    = "HEALTH_SCORE"
+)
+
+type GetMissingTranslationsRequest struct {
+	Locale *string
+}
+
+type MissingTranslation struct {
+	Entity  string   `json:"entity"`
+	ID      string   `json:"id"`
+	Label   string   `json:"label"`
+	Locales []string `json:"locales"`
+}
+
+type GetMissingTranslationsOutput struct {
+	Locales []string             `json:"locales"`
+	Total   int                  `json:"total"`
+	Items   []MissingTranslation `json:"items"`
+}
@@ -0,0 +1,5 @@
+package translation
+
+type TranslationInterface interface {
+	FindMissingTranslations(req *GetMissingTranslationsRequest) (*GetMissingTranslationsOutput, error)
+}
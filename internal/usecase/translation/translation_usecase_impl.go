@@ -0,0 +1,158 @@
+package translation
+
+import (
+	"mucb_be/internal/domain/card"
+	"mucb_be/internal/domain/health_score"
+	"mucb_be/internal/domain/locale"
+	"mucb_be/internal/domain/question"
+	"mucb_be/internal/errors"
+	"net/http"
+	"strconv"
+)
+
+type TranslationUseCaseImpl struct {
+	questionGroupRepo  question.QuestionGroupRepository
+	questionChoiceRepo question.QuestionChoiceRepository
+	cardRepo           card.CardRepository
+	cardCategoryRepo   card.CardCategoryRepository
+	healthScoreRepo    health_score.HealthScoreRepository
+}
+
+func NewTranslationUseCase(
+	questionGroupRepo question.QuestionGroupRepository,
+	questionChoiceRepo question.QuestionChoiceRepository,
+	cardRepo card.CardRepository,
+	cardCategoryRepo card.CardCategoryRepository,
+	healthScoreRepo health_score.HealthScoreRepository,
+) TranslationInterface {
+	return &TranslationUseCaseImpl{
+		questionGroupRepo:  questionGroupRepo,
+		questionChoiceRepo: questionChoiceRepo,
+		cardRepo:           cardRepo,
+		cardCategoryRepo:   cardCategoryRepo,
+		healthScoreRepo:    healthScoreRepo,
+	}
+}
+
+// FindMissingTranslations lists every participant-facing item that has no
+// complete translation for one of the locales, or only for req.Locale.
+func (u *TranslationUseCaseImpl) FindMissingTranslations(req *GetMissingTranslationsRequest) (*GetMissingTranslationsOutput, error) {
+	locales := make([]string, 0, len(locale.Supported))
+	for _, name := range locale.Supported {
+		if name != locale.Default {
+			locales = append(locales, name)
+		}
+	}
+
+	if req.Locale != nil {
+		if *req.Locale == locale.Default || !locale.IsSupported(*req.Locale) {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE014001001",
+				"Invalid locale.",
+				"",
+			)
+		}
+		locales = []string{*req.Locale}
+	}
+
+	items := make([]MissingTranslation, 0)
+	add := func(entity, id, label string, missing []string) {
+		wanted := make([]string, 0, len(missing))
+		for _, name := range missing {
+			for _, target := range locales {
+				if name == target {
+					wanted = append(wanted, name)
+				}
+			}
+		}
+		if len(wanted) > 0 {
+			items = append(items, MissingTranslation{
+				Entity:  entity,
+				ID:      id,
+				Label:   label,
+				Locales: wanted,
+			})
+		}
+	}
+
+	questionGroups, err := u.questionGroupRepo.FindQuestionGroups()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE014001002",
+			"Failed to get question groups.",
+			err.Error(),
+		)
+	}
+	for _, questionGroup := range *questionGroups {
+		if questionGroup.ArchivedAt != nil {
+			continue
+		}
+		add(EntityQuestionGroup, questionGroup.ID.Hex(), questionGroup.ColumnName, questionGroup.MissingLocales())
+	}
+
+	questionChoices, err := u.questionChoiceRepo.FindQuestionChoices()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE014001003",
+			"Failed to get question choices.",
+			err.Error(),
+		)
+	}
+	for _, questionChoice := range *questionChoices {
+		if questionChoice.ArchivedAt != nil {
+			continue
+		}
+		add(EntityQuestionChoice, questionChoice.ID.Hex(), questionChoice.Question, questionChoice.MissingLocales())
+	}
+
+	cards, err := u.cardRepo.FindCards()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE014001004",
+			"Failed to get cards.",
+			err.Error(),
+		)
+	}
+	for _, existCard := range *cards {
+		if existCard.ArchivedAt != nil {
+			continue
+		}
+		add(EntityCard, existCard.ID.Hex(), existCard.Name, existCard.MissingLocales())
+	}
+
+	cardCategories, err := u.cardCategoryRepo.FindAllCardCategories()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE014001006",
+			"Failed to get card categories.",
+			err.Error(),
+		)
+	}
+	for _, cardCategory := range *cardCategories {
+		add(EntityCardCategory, cardCategory.ID.Hex(), cardCategory.Name, cardCategory.MissingLocales())
+	}
+
+	healthScores, err := u.healthScoreRepo.FindAllHealthScore()
+	if err != nil {
+		return nil, errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE014001005",
+			"Failed to get health scores.",
+			err.Error(),
+		)
+	}
+	for _, healthScore := range *healthScores {
+		add(EntityHealthScore, healthScore.ID.Hex(), strconv.Itoa(healthScore.MaximumPercent)+"%", healthScore.MissingLocales())
+	}
+
+	return &GetMissingTranslationsOutput{
+		Locales: locales,
+		Total:   len(items),
+		Items:   items,
+	}, nil
+}
@@ -3,6 +3,8 @@ package user
 type UpdateUserInfoRequest struct {
 	Name      string `json:"name" binding:"required,max=128"`
 	GroupCode string `json:"group" binding:"max=32"`
+	Timezone  string `json:"timezone" binding:"omitempty,max=64"`
+	Language  string `json:"language" binding:"omitempty,oneof=th en"`
 }
 
 type UpdateUserInfoOutput struct {
@@ -18,6 +20,13 @@ type RenewUserOutput struct {
 }
 
 type GetUserInfoRequest struct {
-	Name      string  `json:"name"`
-	GroupCode *string `json:"group"`
+	Name              string  `json:"name"`
+	GroupCode         *string `json:"group"`
+	Timezone          string  `json:"timezone"`
+	Language          string  `json:"language"`
+	CounsellorConsent bool    `json:"counsellorConsent"`
+}
+
+type UpdateCounsellorConsentRequest struct {
+	CounsellorConsent *bool `json:"counsellorConsent" binding:"required"`
 }
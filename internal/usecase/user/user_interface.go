@@ -6,4 +6,5 @@ type UserUseCaseInterface interface {
 	UpdateUserInfo(req *UpdateUserInfoRequest, claims *security.AccessTokenModel) (*UpdateUserInfoOutput, error)
 	GetUserInfo(claims *security.AccessTokenModel) (*GetUserInfoRequest, error)
 	RemoveUserAndInfo(claims *security.AccessTokenModel) error
+	UpdateCounsellorConsent(req *UpdateCounsellorConsentRequest, claims *security.AccessTokenModel) error
 }
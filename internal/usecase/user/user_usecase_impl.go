@@ -2,20 +2,27 @@ package user
 
 import (
 	"mucb_be/internal/domain/auth"
+	"mucb_be/internal/domain/counselling"
+	"mucb_be/internal/domain/locale"
+	"mucb_be/internal/domain/permission"
 	"mucb_be/internal/domain/record"
 	"mucb_be/internal/domain/user"
 	"mucb_be/internal/errors"
+	"mucb_be/internal/infrastructure/authorization"
 	"mucb_be/internal/infrastructure/security"
 	"net/http"
 )
 
 type UserUseCaseImpl struct {
-	userRepo        user.UserRepository
-	groupRecordRepo record.GroupRecordRepository
-	cardRecordRepo  record.CardRecordRepository
-	storyRecordRepo record.StoryRecordRepository
-	authRepo        auth.AuthRepository
-	jwtService      security.JwtServiceInterface
+	userRepo             user.UserRepository
+	groupRecordRepo      record.GroupRecordRepository
+	cardRecordRepo       record.CardRecordRepository
+	storyRecordRepo      record.StoryRecordRepository
+	submissionSlotRepo   record.SubmissionSlotRepository
+	counsellorNoteRepo   counselling.CounsellorNoteRepository
+	authRepo             auth.AuthRepository
+	jwtService           security.JwtServiceInterface
+	authorizationService authorization.AuthorizationServiceInterface
 }
 
 func NewUserUseCase(
@@ -23,27 +30,28 @@ func NewUserUseCase(
 	groupRecordRepo record.GroupRecordRepository,
 	cardRecordRepo record.CardRecordRepository,
 	storyRecordRepo record.StoryRecordRepository,
+	submissionSlotRepo record.SubmissionSlotRepository,
+	counsellorNoteRepo counselling.CounsellorNoteRepository,
 	authRepo auth.AuthRepository,
 	jwtService security.JwtServiceInterface,
+	authorizationService authorization.AuthorizationServiceInterface,
 ) UserUseCaseInterface {
 	return &UserUseCaseImpl{
-		userRepo:        userRepo,
-		groupRecordRepo: groupRecordRepo,
-		cardRecordRepo:  cardRecordRepo,
-		storyRecordRepo: storyRecordRepo,
-		authRepo:        authRepo,
-		jwtService:      jwtService,
+		userRepo:             userRepo,
+		groupRecordRepo:      groupRecordRepo,
+		cardRecordRepo:       cardRecordRepo,
+		storyRecordRepo:      storyRecordRepo,
+		submissionSlotRepo:   submissionSlotRepo,
+		counsellorNoteRepo:   counsellorNoteRepo,
+		authRepo:             authRepo,
+		jwtService:           jwtService,
+		authorizationService: authorizationService,
 	}
 }
 
 func (u *UserUseCaseImpl) UpdateUserInfo(req *UpdateUserInfoRequest, claims *security.AccessTokenModel) (*UpdateUserInfoOutput, error) {
-	if claims.Role != user.RoleUser {
-		return nil, errors.NewCustomError(
-			http.StatusForbidden,
-			"UCE003001001",
-			"Failed to check role.",
-			"",
-		)
+	if err := u.authorizationService.Authorize(claims, permission.ProfileManage); err != nil {
+		return nil, err
 	}
 
 	existUser, err := u.userRepo.FindUserById(claims.ID)
@@ -56,7 +64,25 @@ func (u *UserUseCaseImpl) UpdateUserInfo(req *UpdateUserInfoRequest, claims *sec
 		)
 	}
 
-	err = u.userRepo.UpdateUserInfo(existUser.ID.Hex(), req.Name, req.GroupCode)
+	timezone := existUser.Timezone
+	if req.Timezone != "" {
+		if !user.ValidateTimezone(req.Timezone) {
+			return nil, errors.NewCustomError(
+				http.StatusBadRequest,
+				"UCE003001005",
+				"Invalid timezone.",
+				"",
+			)
+		}
+		timezone = req.Timezone
+	}
+
+	language := existUser.Language
+	if req.Language != "" {
+		language = req.Language
+	}
+
+	err = u.userRepo.UpdateUserInfo(existUser.ID.Hex(), req.Name, req.GroupCode, timezone, language)
 	if err != nil {
 		return nil, errors.NewCustomError(
 			http.StatusBadRequest,
@@ -85,13 +111,8 @@ func (u *UserUseCaseImpl) UpdateUserInfo(req *UpdateUserInfoRequest, claims *sec
 }
 
 func (u *UserUseCaseImpl) GetUserInfo(claims *security.AccessTokenModel) (*GetUserInfoRequest, error) {
-	if claims.Role != user.RoleUser {
-		return nil, errors.NewCustomError(
-			http.StatusForbidden,
-			"UCE003002001",
-			"Failed to check role.",
-			"Failed to check role.",
-		)
+	if err := u.authorizationService.Authorize(claims, permission.ProfileManage); err != nil {
+		return nil, err
 	}
 
 	existUser, err := u.userRepo.FindUserById(claims.ID)
@@ -114,20 +135,18 @@ func (u *UserUseCaseImpl) GetUserInfo(claims *security.AccessTokenModel) (*GetUs
 	}
 
 	return &GetUserInfoRequest{
-		Name:      existUser.Name,
-		GroupCode: existUser.GroupCode,
+		Name:              existUser.Name,
+		GroupCode:         existUser.GroupCode,
+		Timezone:          existUser.Location().String(),
+		Language:          locale.Resolve("", existUser.Language),
+		CounsellorConsent: existUser.CounsellorConsent,
 	}, nil
 }
 
 // RemoveUserAndInfo implements UserUseCaseInterface.
 func (u *UserUseCaseImpl) RemoveUserAndInfo(claims *security.AccessTokenModel) error {
-	if claims.Role != user.RoleUser {
-		return errors.NewCustomError(
-			http.StatusForbidden,
-			"UCE003003001",
-			"Failed to check role.",
-			"Failed to check role.",
-		)
+	if err := u.authorizationService.Authorize(claims, permission.ProfileManage); err != nil {
+		return err
 	}
 
 	err := u.userRepo.RemoveUserById(claims.ID)
@@ -143,7 +162,27 @@ func (u *UserUseCaseImpl) RemoveUserAndInfo(claims *security.AccessTokenModel) e
 	u.cardRecordRepo.RemoveDataByUserId(claims.ID)
 	u.groupRecordRepo.RemoveDataByUserId(claims.ID)
 	u.storyRecordRepo.RemoveDataByUserId(claims.ID)
+	u.submissionSlotRepo.RemoveDataByUserId(claims.ID)
+	u.counsellorNoteRepo.RemoveDataByUserId(claims.ID)
 	u.authRepo.RemoveTokenByUserId(claims.ID)
 
 	return nil
 }
+
+func (u *UserUseCaseImpl) UpdateCounsellorConsent(req *UpdateCounsellorConsentRequest, claims *security.AccessTokenModel) error {
+	if err := u.authorizationService.Authorize(claims, permission.ProfileManage); err != nil {
+		return err
+	}
+
+	err := u.userRepo.UpdateCounsellorConsent(claims.ID, *req.CounsellorConsent)
+	if err != nil {
+		return errors.NewCustomError(
+			http.StatusBadRequest,
+			"UCE003004002",
+			"Failed to update consent.",
+			err.Error(),
+		)
+	}
+
+	return nil
+}
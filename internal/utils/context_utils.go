@@ -28,3 +28,8 @@ func GetUserClaims(c *gin.Context) (*security.AccessTokenModel, error) {
 
 	return &claims, nil
 }
+
+// ✅ SetAuditTarget records the id of a created entity for AuditMiddleware
+func SetAuditTarget(c *gin.Context, id string) {
+	c.Set("auditTarget", id)
+}